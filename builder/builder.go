@@ -0,0 +1,129 @@
+package builder
+
+import (
+	"errors"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+	"github.com/jkratz55/gonads/validated"
+)
+
+// ErrRequired is reported for a required Field that wasn't set.
+var ErrRequired = errors.New("is required")
+
+// field is the type-erased view of a Field used by Builder.
+type field[T any] interface {
+	apply(dst *T) option.Option[validated.FieldError]
+}
+
+// Builder builds values of T from Fields, tracking which Fields were explicitly
+// set and validating that every required Field was set when building.
+//
+// Fields are declared with Required and Optional and set using their Set method,
+// which keeps building type-safe without reflection.
+//
+//	b := builder.New[Config]()
+//	host := builder.Required(b, "host", func(c *Config, v string) { c.Host = v })
+//	port := builder.Optional(b, "port", func(c *Config, v int) { c.Port = v })
+//	host.Set("localhost")
+//	cfg := b.Build()
+//
+// A Builder isn't safe for concurrent use. The zero value isn't usable and a
+// Builder needs to be created with New.
+type Builder[T any] struct {
+	base   T
+	fields []field[T]
+}
+
+// New creates a Builder that builds values starting from the zero value of T.
+func New[T any]() *Builder[T] {
+	return &Builder[T]{}
+}
+
+// From creates a Builder that builds values starting from a copy of base, which
+// is useful for providing defaults for Optional fields.
+func From[T any](base T) *Builder[T] {
+	return &Builder[T]{base: base}
+}
+
+// Build builds the value, applying every Field that was set in the order the
+// Fields were declared. If any required Field wasn't set an Error Result
+// containing a validated.Errors with an ErrRequired entry for each missing Field
+// is returned.
+func (b *Builder[T]) Build() result.Result[T] {
+	val := b.base
+	errs := make(validated.Errors, 0)
+	for _, f := range b.fields {
+		f.apply(&val).IfSome(func(err validated.FieldError) {
+			errs = append(errs, err)
+		})
+	}
+	if len(errs) > 0 {
+		return result.Error[T](errs)
+	}
+	return result.Ok(val)
+}
+
+// Field is a field of T that can be set on a Builder.
+type Field[T, V any] struct {
+	name     string
+	required bool
+	value    option.Option[V]
+	set      func(dst *T, val V)
+}
+
+// Required declares a Field on the Builder that must be set before building.
+func Required[T, V any](b *Builder[T], name string, set func(dst *T, val V)) *Field[T, V] {
+	return declare(b, name, true, set)
+}
+
+// Optional declares a Field on the Builder that may be left unset, in which case
+// the value from the base of the Builder is kept.
+func Optional[T, V any](b *Builder[T], name string, set func(dst *T, val V)) *Field[T, V] {
+	return declare(b, name, false, set)
+}
+
+func declare[T, V any](b *Builder[T], name string, required bool, set func(dst *T, val V)) *Field[T, V] {
+	f := &Field[T, V]{
+		name:     name,
+		required: required,
+		value:    option.None[V](),
+		set:      set,
+	}
+	b.fields = append(b.fields, f)
+	return f
+}
+
+// Set sets the value of the Field, replacing any previously set value.
+func (f *Field[T, V]) Set(val V) *Field[T, V] {
+	f.value = option.Some(val)
+	return f
+}
+
+// Unset clears the value of the Field.
+func (f *Field[T, V]) Unset() *Field[T, V] {
+	f.value = option.None[V]()
+	return f
+}
+
+// Value returns the value of the Field if it was set, otherwise None.
+func (f *Field[T, V]) Value() option.Option[V] {
+	return f.value
+}
+
+// Name returns the name of the Field.
+func (f *Field[T, V]) Name() string {
+	return f.name
+}
+
+func (f *Field[T, V]) apply(dst *T) option.Option[validated.FieldError] {
+	val, ok := f.value.Get()
+	if !ok {
+		if f.required {
+			return option.Some(validated.FieldError{Field: f.name, Err: ErrRequired})
+		}
+		return option.None[validated.FieldError]()
+	}
+	f.set(dst, val)
+	return option.None[validated.FieldError]()
+}
@@ -0,0 +1,52 @@
+package builder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/validated"
+)
+
+type config struct {
+	Host string
+	Port int
+	TLS  bool
+}
+
+func TestBuilder(t *testing.T) {
+	b := From(config{Port: 8080})
+	host := Required(b, "host", func(c *config, v string) { c.Host = v })
+	port := Optional(b, "port", func(c *config, v int) { c.Port = v })
+	tls := Optional(b, "tls", func(c *config, v bool) { c.TLS = v })
+
+	host.Set("localhost")
+	tls.Set(true)
+	assert.Equal(t, config{Host: "localhost", Port: 8080, TLS: true}, b.Build().Unwrap())
+	assert.True(t, port.Value().IsNone())
+
+	port.Set(9090)
+	assert.Equal(t, config{Host: "localhost", Port: 9090, TLS: true}, b.Build().Unwrap())
+	assert.Equal(t, "port", port.Name())
+}
+
+func TestBuilder_MissingRequired(t *testing.T) {
+	b := New[config]()
+	host := Required(b, "host", func(c *config, v string) { c.Host = v })
+	Required(b, "port", func(c *config, v int) { c.Port = v })
+
+	_, err := b.Build().Get()
+	assert.ErrorIs(t, err, ErrRequired)
+
+	var errs validated.Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, map[string][]string{
+		"host": {"is required"},
+		"port": {"is required"},
+	}, errs.Fields())
+
+	host.Set("localhost").Unset()
+	_, err = b.Build().Get()
+	assert.Len(t, err.(validated.Errors), 2)
+}
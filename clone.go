@@ -0,0 +1,16 @@
+package gonads
+
+// Cloner is implemented by types that can produce a deep copy of themselves.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// Clone returns a copy of val. If val implements Cloner its Clone method is used,
+// otherwise val is copied by assignment, which is a shallow copy for types
+// containing pointers, slices, or maps.
+func Clone[T any](val T) T {
+	if c, ok := any(val).(Cloner[T]); ok {
+		return c.Clone()
+	}
+	return val
+}
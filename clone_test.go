@@ -0,0 +1,24 @@
+package gonads_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+)
+
+type tags []string
+
+func (t tags) Clone() tags {
+	return append(tags{}, t...)
+}
+
+func TestClone(t *testing.T) {
+	original := tags{"a", "b"}
+	clone := gonads.Clone(original)
+	clone[0] = "z"
+	assert.Equal(t, tags{"a", "b"}, original)
+
+	assert.Equal(t, 42, gonads.Clone(42))
+}
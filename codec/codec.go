@@ -0,0 +1,179 @@
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrNotRegistered is returned when marshalling or unmarshalling a type that has
+// no codec registered.
+var ErrNotRegistered = errors.New("no codec registered for type")
+
+// Default is the Registry used by applications that don't need more than one.
+var Default = NewRegistry()
+
+type entry struct {
+	marshal   func(val any) ([]byte, error)
+	unmarshal func(data []byte) (any, error)
+}
+
+// Registry holds the marshal and unmarshal functions for user types so that
+// Options and Results containing them can be persisted in storage layers
+// without relying on reflection based encodings such as gob.
+//
+// Registry is safe for concurrent use. The zero value isn't usable and a
+// Registry needs to be created with NewRegistry.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[reflect.Type]entry
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{entries: make(map[reflect.Type]entry)}
+}
+
+// Register registers the marshal and unmarshal functions for T, replacing any
+// functions previously registered for T.
+func Register[T any](r *Registry, marshal func(val T) ([]byte, error), unmarshal func(data []byte) (T, error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[reflect.TypeFor[T]()] = entry{
+		marshal: func(val any) ([]byte, error) {
+			// A nil interface T is passed as a nil any.
+			v, _ := val.(T)
+			return marshal(v)
+		},
+		unmarshal: func(data []byte) (any, error) {
+			return unmarshal(data)
+		},
+	}
+}
+
+// Registered returns a boolean indicating if T has a codec registered.
+func Registered[T any](r *Registry) bool {
+	_, ok := r.lookup(reflect.TypeFor[T]())
+	return ok
+}
+
+// Marshal marshals the value using the functions registered for T.
+func Marshal[T any](r *Registry, val T) ([]byte, error) {
+	e, ok := r.lookup(reflect.TypeFor[T]())
+	if !ok {
+		return nil, notRegistered[T]()
+	}
+	return e.marshal(val)
+}
+
+// Unmarshal unmarshalls the data using the functions registered for T.
+func Unmarshal[T any](r *Registry, data []byte) (T, error) {
+	e, ok := r.lookup(reflect.TypeFor[T]())
+	if !ok {
+		var zero T
+		return zero, notRegistered[T]()
+	}
+	val, err := e.unmarshal(data)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	v, _ := val.(T)
+	return v, nil
+}
+
+// For returns an option.Codec for T backed by the Registry, allowing it to be used
+// with option.Encode and option.Decode. If T isn't registered, the Codec returns
+// ErrNotRegistered when used.
+func For[T any](r *Registry) option.Codec[T] {
+	return typedCodec[T]{registry: r}
+}
+
+type typedCodec[T any] struct {
+	registry *Registry
+}
+
+func (c typedCodec[T]) Marshal(val T) ([]byte, error) {
+	return Marshal(c.registry, val)
+}
+
+func (c typedCodec[T]) Unmarshal(data []byte) (T, error) {
+	return Unmarshal[T](c.registry, data)
+}
+
+// EncodeOption encodes an Option using option.Encode with the codec registered
+// for T.
+func EncodeOption[T any](r *Registry, opt option.Option[T]) ([]byte, error) {
+	return option.Encode(opt, For[T](r))
+}
+
+// DecodeOption decodes an Option encoded by EncodeOption.
+func DecodeOption[T any](r *Registry, data []byte) (option.Option[T], error) {
+	return option.Decode(data, For[T](r))
+}
+
+const (
+	resultVersion = 1
+	resultErr     = 0
+	resultOk      = 1
+)
+
+// ErrInvalidResultFrame is returned when decoding data that isn't a valid encoded
+// Result.
+var ErrInvalidResultFrame = errors.New("invalid encoded Result frame")
+
+// EncodeResult encodes a Result into a compact binary frame. The frame consists of
+// a version byte, a status byte, and either the value encoded with the codec
+// registered for T or the error message.
+func EncodeResult[T any](r *Registry, res result.Result[T]) ([]byte, error) {
+	val, err := res.Get()
+	if err != nil {
+		msg := err.Error()
+		frame := make([]byte, 0, len(msg)+2)
+		frame = append(frame, resultVersion, resultErr)
+		return append(frame, msg...), nil
+	}
+	payload, err := Marshal(r, val)
+	if err != nil {
+		return nil, fmt.Errorf("encode Result value: %w", err)
+	}
+	frame := make([]byte, 0, len(payload)+2)
+	frame = append(frame, resultVersion, resultOk)
+	return append(frame, payload...), nil
+}
+
+// DecodeResult decodes a Result encoded by EncodeResult. Since only the error
+// message is encoded, errors are decoded using errors.New and won't match the
+// original error with errors.Is.
+func DecodeResult[T any](r *Registry, data []byte) (result.Result[T], error) {
+	if len(data) < 2 || data[0] != resultVersion {
+		return result.Result[T]{}, ErrInvalidResultFrame
+	}
+	switch data[1] {
+	case resultErr:
+		return result.Error[T](errors.New(string(data[2:]))), nil
+	case resultOk:
+		val, err := Unmarshal[T](r, data[2:])
+		if err != nil {
+			return result.Result[T]{}, fmt.Errorf("decode Result value: %w", err)
+		}
+		return result.Ok(val), nil
+	default:
+		return result.Result[T]{}, ErrInvalidResultFrame
+	}
+}
+
+func (r *Registry) lookup(typ reflect.Type) (entry, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.entries[typ]
+	return e, ok
+}
+
+func notRegistered[T any]() error {
+	return fmt.Errorf("%w %s", ErrNotRegistered, reflect.TypeFor[T]())
+}
@@ -0,0 +1,128 @@
+package codec
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+type userID struct {
+	Tenant string
+	ID     int
+}
+
+func marshalUserID(u userID) ([]byte, error) {
+	return []byte(u.Tenant + ":" + strconv.Itoa(u.ID)), nil
+}
+
+func unmarshalUserID(data []byte) (userID, error) {
+	tenant, id, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return userID{}, errors.New("malformed user id")
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return userID{}, err
+	}
+	return userID{Tenant: tenant, ID: n}, nil
+}
+
+func newTestRegistry() *Registry {
+	r := NewRegistry()
+	Register(r, marshalUserID, unmarshalUserID)
+	return r
+}
+
+func TestRegistry_MarshalUnmarshal(t *testing.T) {
+	r := newTestRegistry()
+	assert.True(t, Registered[userID](r))
+	assert.False(t, Registered[string](r))
+
+	data, err := Marshal(r, userID{Tenant: "acme", ID: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme:7", string(data))
+
+	u, err := Unmarshal[userID](r, data)
+	assert.NoError(t, err)
+	assert.Equal(t, userID{Tenant: "acme", ID: 7}, u)
+
+	_, err = Unmarshal[userID](r, []byte("bad"))
+	assert.Error(t, err)
+
+	_, err = Marshal(r, "not registered")
+	assert.ErrorIs(t, err, ErrNotRegistered)
+	_, err = Unmarshal[string](r, nil)
+	assert.ErrorIs(t, err, ErrNotRegistered)
+}
+
+func TestRegistry_NilInterface(t *testing.T) {
+	r := NewRegistry()
+	Register(r, func(err error) ([]byte, error) {
+		if err == nil {
+			return nil, nil
+		}
+		return []byte(err.Error()), nil
+	}, func(data []byte) (error, error) {
+		if len(data) == 0 {
+			return nil, nil
+		}
+		return errors.New(string(data)), nil
+	})
+
+	data, err := Marshal[error](r, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+
+	val, err := Unmarshal[error](r, data)
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
+
+func TestEncodeDecodeOption(t *testing.T) {
+	r := newTestRegistry()
+
+	data, err := EncodeOption(r, option.Some(userID{Tenant: "acme", ID: 7}))
+	assert.NoError(t, err)
+	opt, err := DecodeOption[userID](r, data)
+	assert.NoError(t, err)
+	assert.Equal(t, option.Some(userID{Tenant: "acme", ID: 7}), opt)
+
+	data, err = EncodeOption(r, option.None[userID]())
+	assert.NoError(t, err)
+	opt, err = DecodeOption[userID](r, data)
+	assert.NoError(t, err)
+	assert.True(t, opt.IsNone())
+
+	_, err = option.Encode(option.Some(1), For[int](r))
+	assert.ErrorIs(t, err, ErrNotRegistered)
+}
+
+func TestEncodeDecodeResult(t *testing.T) {
+	r := newTestRegistry()
+
+	data, err := EncodeResult(r, result.Ok(userID{Tenant: "acme", ID: 7}))
+	assert.NoError(t, err)
+	res, err := DecodeResult[userID](r, data)
+	assert.NoError(t, err)
+	assert.Equal(t, result.Ok(userID{Tenant: "acme", ID: 7}), res)
+
+	data, err = EncodeResult(r, result.Error[userID](errors.New("not found")))
+	assert.NoError(t, err)
+	res, err = DecodeResult[userID](r, data)
+	assert.NoError(t, err)
+	_, resErr := res.Get()
+	assert.EqualError(t, resErr, "not found")
+
+	_, err = DecodeResult[userID](r, []byte{9, 1})
+	assert.ErrorIs(t, err, ErrInvalidResultFrame)
+	_, err = DecodeResult[userID](r, []byte{1, 5})
+	assert.ErrorIs(t, err, ErrInvalidResultFrame)
+	_, err = EncodeResult(r, result.Ok(1))
+	assert.ErrorIs(t, err, ErrNotRegistered)
+}
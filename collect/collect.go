@@ -0,0 +1,181 @@
+package collect
+
+import (
+	"container/heap"
+	"iter"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+)
+
+// randN returns a random int in [0, n), it is replaced in tests.
+var randN = rand.IntN
+
+// Collector accumulates values one at a time.
+type Collector[T any] interface {
+	Add(val T)
+}
+
+// Into adds every value of the sequence to the Collector and returns the
+// Collector, allowing a collector to be used as the terminal of an iterator
+// pipeline.
+//
+//	top := collect.Into(values, collect.TopK(10, cmp.Compare[int]))
+func Into[T any, C Collector[T]](seq iter.Seq[T], c C) C {
+	for val := range seq {
+		c.Add(val)
+	}
+	return c
+}
+
+// Top keeps the k largest values added to it according to a Comparator, using
+// memory proportional to k regardless of how many values are added.
+//
+// Top isn't safe for concurrent use. The zero value isn't usable and a Top needs
+// to be created with TopK.
+type Top[T any] struct {
+	k    int
+	heap minHeap[T]
+}
+
+// TopK creates a Top collector keeping the k largest values. TopK panics if k is
+// less than 1.
+func TopK[T any](k int, cmp gonads.Comparator[T]) *Top[T] {
+	if k < 1 {
+		panic("k must be at least 1")
+	}
+	return &Top[T]{k: k, heap: minHeap[T]{cmp: cmp, vals: make([]T, 0, k)}}
+}
+
+// Add adds a value, keeping it only if it is among the k largest seen so far.
+func (t *Top[T]) Add(val T) {
+	if len(t.heap.vals) < t.k {
+		heap.Push(&t.heap, val)
+		return
+	}
+	if t.heap.cmp(val, t.heap.vals[0]) > 0 {
+		t.heap.vals[0] = val
+		heap.Fix(&t.heap, 0)
+	}
+}
+
+// Len returns the number of values kept, which is at most k.
+func (t *Top[T]) Len() int {
+	return len(t.heap.vals)
+}
+
+// Values returns the kept values from largest to smallest.
+func (t *Top[T]) Values() []T {
+	vals := slices.Clone(t.heap.vals)
+	slices.SortStableFunc(vals, func(a, b T) int {
+		return t.heap.cmp(b, a)
+	})
+	return vals
+}
+
+// Max returns the largest value, or None if no values were added.
+func (t *Top[T]) Max() option.Option[T] {
+	if len(t.heap.vals) == 0 {
+		return option.None[T]()
+	}
+	largest := t.heap.vals[0]
+	for _, val := range t.heap.vals[1:] {
+		if t.heap.cmp(val, largest) > 0 {
+			largest = val
+		}
+	}
+	return option.Some(largest)
+}
+
+// Min returns the smallest of the kept values, or None if no values were added.
+func (t *Top[T]) Min() option.Option[T] {
+	if len(t.heap.vals) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(t.heap.vals[0])
+}
+
+// minHeap implements heap.Interface ordered by cmp.
+type minHeap[T any] struct {
+	cmp  gonads.Comparator[T]
+	vals []T
+}
+
+func (h *minHeap[T]) Len() int           { return len(h.vals) }
+func (h *minHeap[T]) Less(i, j int) bool { return h.cmp(h.vals[i], h.vals[j]) < 0 }
+func (h *minHeap[T]) Swap(i, j int)      { h.vals[i], h.vals[j] = h.vals[j], h.vals[i] }
+func (h *minHeap[T]) Push(x any)         { h.vals = append(h.vals, x.(T)) }
+func (h *minHeap[T]) Pop() any {
+	n := len(h.vals) - 1
+	val := h.vals[n]
+	h.vals = h.vals[:n]
+	return val
+}
+
+// Reservoir keeps a uniform random sample of k values from all the values added
+// to it, using memory proportional to k regardless of how many values are added.
+//
+// Reservoir isn't safe for concurrent use. The zero value isn't usable and a
+// Reservoir needs to be created with ReservoirSample.
+type Reservoir[T any] struct {
+	k      int
+	seen   int
+	sample []T
+}
+
+// ReservoirSample creates a Reservoir collector keeping a sample of k values.
+// ReservoirSample panics if k is less than 1.
+func ReservoirSample[T any](k int) *Reservoir[T] {
+	if k < 1 {
+		panic("k must be at least 1")
+	}
+	return &Reservoir[T]{k: k, sample: make([]T, 0, k)}
+}
+
+// Add adds a value, which replaces a random sampled value with probability k/n
+// once the sample is full, where n is the number of values seen.
+func (r *Reservoir[T]) Add(val T) {
+	r.seen++
+	if len(r.sample) < r.k {
+		r.sample = append(r.sample, val)
+		return
+	}
+	if i := randN(r.seen); i < r.k {
+		r.sample[i] = val
+	}
+}
+
+// Len returns the number of sampled values, which is at most k.
+func (r *Reservoir[T]) Len() int {
+	return len(r.sample)
+}
+
+// Seen returns the total number of values added.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Values returns the sampled values. The order of the values is unspecified.
+func (r *Reservoir[T]) Values() []T {
+	return slices.Clone(r.sample)
+}
+
+// MaxBy returns the largest sampled value according to the Comparator, or None if
+// no values were added.
+func (r *Reservoir[T]) MaxBy(cmp gonads.Comparator[T]) option.Option[T] {
+	if len(r.sample) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(slices.MaxFunc(r.sample, cmp))
+}
+
+// MinBy returns the smallest sampled value according to the Comparator, or None if
+// no values were added.
+func (r *Reservoir[T]) MinBy(cmp gonads.Comparator[T]) option.Option[T] {
+	if len(r.sample) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(slices.MinFunc(r.sample, cmp))
+}
@@ -0,0 +1,53 @@
+package collect
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestTopK(t *testing.T) {
+	assert.Panics(t, func() { TopK(0, cmp.Compare[int]) })
+
+	top := TopK(3, cmp.Compare[int])
+	assert.Equal(t, option.None[int](), top.Max())
+	assert.Equal(t, option.None[int](), top.Min())
+	assert.Equal(t, []int{}, top.Values())
+
+	top = Into(slices.Values([]int{5, 1, 9, 3, 7, 2, 8}), top)
+	assert.Equal(t, 3, top.Len())
+	assert.Equal(t, []int{9, 8, 7}, top.Values())
+	assert.Equal(t, option.Some(9), top.Max())
+	assert.Equal(t, option.Some(7), top.Min())
+}
+
+func TestTopK_FewerThanK(t *testing.T) {
+	top := Into(slices.Values([]string{"b", "a"}), TopK(5, cmp.Compare[string]))
+	assert.Equal(t, []string{"b", "a"}, top.Values())
+}
+
+func TestReservoirSample(t *testing.T) {
+	assert.Panics(t, func() { ReservoirSample[int](0) })
+
+	r := ReservoirSample[int](3)
+	assert.Equal(t, option.None[int](), r.MaxBy(cmp.Compare[int]))
+	assert.Equal(t, option.None[int](), r.MinBy(cmp.Compare[int]))
+
+	randN = func(n int) int { return n - 1 }
+	t.Cleanup(func() { randN = rand.IntN })
+	r = Into(slices.Values([]int{1, 2, 3, 4, 5}), r)
+	assert.Equal(t, []int{1, 2, 3}, r.Values())
+	assert.Equal(t, 5, r.Seen())
+
+	randN = func(n int) int { return 0 }
+	r.Add(6)
+	assert.Equal(t, []int{6, 2, 3}, r.Values())
+	assert.Equal(t, option.Some(6), r.MaxBy(cmp.Compare[int]))
+	assert.Equal(t, option.Some(2), r.MinBy(cmp.Compare[int]))
+	assert.Equal(t, 3, r.Len())
+}
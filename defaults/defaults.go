@@ -0,0 +1,94 @@
+package defaults
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/jkratz55/gonads/validated"
+)
+
+var (
+	// ErrRequired is reported for fields tagged as required that are None.
+	ErrRequired = errors.New("is required")
+	// ErrInvalidTarget is returned when Apply is called with something other than
+	// a non-nil pointer to a struct.
+	ErrInvalidTarget = errors.New("defaults can only be applied to a non-nil pointer to a struct")
+)
+
+// optionField is satisfied by *option.Option.
+type optionField interface {
+	IsNone() bool
+	encoding.TextUnmarshaler
+}
+
+// Apply fills the None Option fields of the struct pointed to by v using the
+// value of their `default` struct tag, and reports fields tagged `required:"true"`
+// that are still None. Apply is intended to run after decoding configuration or
+// a request so that values provided by the source take precedence.
+//
+//	type Config struct {
+//		Host option.Option[string] `default:"localhost"`
+//		Port option.Option[int]    `default:"8080"`
+//		Key  option.Option[string] `required:"true"`
+//	}
+//
+// Default values are parsed using the UnmarshalText method of the Option. Nested
+// structs and pointers to structs are traversed, and field names in errors are
+// the dotted path to the field.
+//
+// If any field is missing or has an invalid default, a validated.Errors is
+// returned containing every problem found.
+func Apply(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+	errs := apply(rv.Elem(), "")
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func apply(rv reflect.Value, prefix string) validated.Errors {
+	errs := make(validated.Errors, 0)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		path := prefix + sf.Name
+
+		if opt, ok := fv.Addr().Interface().(optionField); ok {
+			if !opt.IsNone() {
+				continue
+			}
+			if def, ok := sf.Tag.Lookup("default"); ok {
+				if err := opt.UnmarshalText([]byte(def)); err != nil {
+					errs = append(errs, validated.FieldError{
+						Field: path,
+						Err:   fmt.Errorf("invalid default %q: %w", def, err),
+					})
+				}
+				continue
+			}
+			if required, _ := strconv.ParseBool(sf.Tag.Get("required")); required {
+				errs = append(errs, validated.FieldError{Field: path, Err: ErrRequired})
+			}
+			continue
+		}
+
+		switch {
+		case fv.Kind() == reflect.Struct:
+			errs = append(errs, apply(fv, path+".")...)
+		case fv.Kind() == reflect.Pointer && !fv.IsNil() && fv.Elem().Kind() == reflect.Struct:
+			errs = append(errs, apply(fv.Elem(), path+".")...)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,80 @@
+package defaults
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/validated"
+)
+
+type database struct {
+	Host option.Option[string] `default:"localhost"`
+	User option.Option[string] `required:"true"`
+}
+
+type config struct {
+	Name     string
+	Port     option.Option[int]           `default:"8080"`
+	Debug    option.Option[bool]          `default:"false"`
+	Timeout  option.Option[time.Duration] `default:"30s"`
+	APIKey   option.Option[string]        `required:"true"`
+	Motto    option.Option[string]
+	Database database
+	Cache    *database
+	internal option.Option[string] `default:"ignored"`
+}
+
+func TestApply(t *testing.T) {
+	cfg := config{
+		Port:     option.Some(9090),
+		APIKey:   option.Some("secret"),
+		Database: database{User: option.Some("admin")},
+	}
+	assert.NoError(t, Apply(&cfg))
+	assert.Equal(t, option.Some(9090), cfg.Port)
+	assert.Equal(t, option.Some(false), cfg.Debug)
+	assert.Equal(t, option.Some(30*time.Second), cfg.Timeout)
+	assert.Equal(t, option.Some("secret"), cfg.APIKey)
+	assert.True(t, cfg.Motto.IsNone())
+	assert.Equal(t, option.Some("localhost"), cfg.Database.Host)
+	assert.True(t, cfg.internal.IsNone())
+}
+
+func TestApply_Required(t *testing.T) {
+	cfg := config{Cache: &database{}}
+	err := Apply(&cfg)
+	assert.ErrorIs(t, err, ErrRequired)
+
+	var errs validated.Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, map[string][]string{
+		"APIKey":        {"is required"},
+		"Database.User": {"is required"},
+		"Cache.User":    {"is required"},
+	}, errs.Fields())
+
+	// Defaults are still applied when required fields are missing
+	assert.Equal(t, option.Some(8080), cfg.Port)
+	assert.Equal(t, option.Some("localhost"), cfg.Cache.Host)
+}
+
+func TestApply_InvalidDefault(t *testing.T) {
+	type bad struct {
+		Port option.Option[int] `default:"abc"`
+	}
+	err := Apply(&bad{})
+	var errs validated.Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "Port", errs[0].Field)
+}
+
+func TestApply_InvalidTarget(t *testing.T) {
+	assert.ErrorIs(t, Apply(config{}), ErrInvalidTarget)
+	assert.ErrorIs(t, Apply((*config)(nil)), ErrInvalidTarget)
+	assert.ErrorIs(t, Apply(new(int)), ErrInvalidTarget)
+}
@@ -0,0 +1,217 @@
+package gonads
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+)
+
+// pairLike is satisfied by Pair.
+type pairLike interface {
+	pair() (any, any)
+}
+
+func (p Pair[K, V]) pair() (any, any) {
+	return p.Key, p.Value
+}
+
+// tupleLike is satisfied by Tuple.
+type tupleLike interface {
+	tuple() (any, any)
+}
+
+func (t Tuple[A, B]) tuple() (any, any) {
+	return t.First, t.Second
+}
+
+// Dump renders v as an indented tree, unwrapping any nested Option, Result, Pair,
+// and Tuple values along the way. Errors contained in a Result are rendered along
+// with the chain of errors they wrap. Structs, slices, arrays, maps, and pointers
+// are walked so Options and Results held in their fields and elements are
+// unwrapped too, unless the type implements fmt.Stringer in which case its String
+// method is used.
+//
+// Dump is intended for debugging deeply composed values in tests and logs, the
+// output format isn't stable and shouldn't be parsed.
+func Dump(v any) string {
+	var sb strings.Builder
+	dump(&sb, v, 0)
+	return sb.String()
+}
+
+// Fdump writes the output of Dump for v followed by a newline to w.
+func Fdump(w io.Writer, v any) error {
+	_, err := io.WriteString(w, Dump(v)+"\n")
+	return err
+}
+
+func dump(sb *strings.Builder, v any, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if depth >= maxDumpDepth {
+		// Likely a cycle, stop descending.
+		sb.WriteString(indent + "...")
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	if val, ok, isOption := reflectopt.Option(rv); isOption {
+		if !ok {
+			sb.WriteString(indent + "None")
+			return
+		}
+		sb.WriteString(indent + "Some(\n")
+		dump(sb, val.Interface(), depth+1)
+		sb.WriteString("\n" + indent + ")")
+		return
+	}
+	if val, err, isResult := reflectopt.Result(rv); isResult {
+		if err != nil {
+			sb.WriteString(indent + "Err(\n")
+			dumpError(sb, err, depth+1)
+			sb.WriteString("\n" + indent + ")")
+			return
+		}
+		sb.WriteString(indent + "Ok(\n")
+		dump(sb, val.Interface(), depth+1)
+		sb.WriteString("\n" + indent + ")")
+		return
+	}
+
+	switch val := v.(type) {
+	case nil:
+		sb.WriteString(indent + "nil")
+	case pairLike:
+		key, value := val.pair()
+		sb.WriteString(indent + "Pair(\n")
+		dump(sb, key, depth+1)
+		sb.WriteString("\n")
+		dump(sb, value, depth+1)
+		sb.WriteString("\n" + indent + ")")
+	case tupleLike:
+		first, second := val.tuple()
+		sb.WriteString(indent + "Tuple(\n")
+		dump(sb, first, depth+1)
+		sb.WriteString("\n")
+		dump(sb, second, depth+1)
+		sb.WriteString("\n" + indent + ")")
+	case error:
+		dumpError(sb, val, depth)
+	case string:
+		sb.WriteString(indent + fmt.Sprintf("%q", val))
+	case fmt.Stringer:
+		sb.WriteString(indent + val.String())
+	default:
+		dumpValue(sb, reflect.ValueOf(v), depth)
+	}
+}
+
+// maxDumpDepth bounds how deep values are rendered so cyclic values terminate.
+const maxDumpDepth = 32
+
+// dumpValue walks composite values so nested Options and Results are unwrapped.
+// Anything else is rendered with %+v.
+func dumpValue(sb *strings.Builder, rv reflect.Value, depth int) {
+	indent := strings.Repeat("  ", depth)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			sb.WriteString(indent + "nil")
+			return
+		}
+		sb.WriteString(indent + "&")
+		dumpInline(sb, rv.Elem().Interface(), depth)
+	case reflect.Struct:
+		typ := rv.Type()
+		var fields []int
+		for i := range typ.NumField() {
+			if typ.Field(i).IsExported() {
+				fields = append(fields, i)
+			}
+		}
+		if len(fields) == 0 {
+			sb.WriteString(indent + fmt.Sprintf("%+v", rv.Interface()))
+			return
+		}
+		sb.WriteString(indent + typ.String() + "{\n")
+		for _, i := range fields {
+			sb.WriteString(strings.Repeat("  ", depth+1) + typ.Field(i).Name + ": ")
+			dumpInline(sb, rv.Field(i).Interface(), depth+1)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + "}")
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			sb.WriteString(indent + "nil")
+			return
+		}
+		if rv.Len() == 0 {
+			sb.WriteString(indent + rv.Type().String() + "{}")
+			return
+		}
+		sb.WriteString(indent + rv.Type().String() + "{\n")
+		for i := range rv.Len() {
+			dump(sb, rv.Index(i).Interface(), depth+1)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + "}")
+	case reflect.Map:
+		if rv.IsNil() {
+			sb.WriteString(indent + "nil")
+			return
+		}
+		if rv.Len() == 0 {
+			sb.WriteString(indent + rv.Type().String() + "{}")
+			return
+		}
+		// Sort the keys by their rendering so the output is deterministic.
+		keys := make(map[string]reflect.Value, rv.Len())
+		names := make([]string, 0, rv.Len())
+		for _, key := range rv.MapKeys() {
+			name := fmt.Sprintf("%#v", key.Interface())
+			keys[name] = key
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		sb.WriteString(indent + rv.Type().String() + "{\n")
+		for _, name := range names {
+			sb.WriteString(strings.Repeat("  ", depth+1) + name + ": ")
+			dumpInline(sb, rv.MapIndex(keys[name]).Interface(), depth+1)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + "}")
+	default:
+		sb.WriteString(indent + fmt.Sprintf("%+v", rv.Interface()))
+	}
+}
+
+// dumpInline renders v at depth without indenting its first line, for values
+// following a field name or map key.
+func dumpInline(sb *strings.Builder, v any, depth int) {
+	var inner strings.Builder
+	dump(&inner, v, depth)
+	sb.WriteString(strings.TrimPrefix(inner.String(), strings.Repeat("  ", depth)))
+}
+
+func dumpError(sb *strings.Builder, err error, depth int) {
+	indent := strings.Repeat("  ", depth)
+	sb.WriteString(indent + strings.ReplaceAll(err.Error(), "\n", "\n"+indent))
+
+	var causes []error
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		causes = e.Unwrap()
+	default:
+		if cause := errors.Unwrap(err); cause != nil {
+			causes = []error{cause}
+		}
+	}
+	for _, cause := range causes {
+		sb.WriteString("\n" + strings.Repeat("  ", depth+1) + "caused by:\n")
+		dumpError(sb, cause, depth+2)
+	}
+}
@@ -0,0 +1,169 @@
+package gonads_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+func TestDump(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      any
+		expected string
+	}{
+		{
+			name:     "Nil",
+			val:      nil,
+			expected: "nil",
+		},
+		{
+			name:     "Plain Value",
+			val:      42,
+			expected: "42",
+		},
+		{
+			name:     "None",
+			val:      option.None[string](),
+			expected: "None",
+		},
+		{
+			name:     "Some String",
+			val:      option.Some("Billy Bob"),
+			expected: "Some(\n  \"Billy Bob\"\n)",
+		},
+		{
+			name:     "Some Ok",
+			val:      option.Some(result.Ok(1)),
+			expected: "Some(\n  Ok(\n    1\n  )\n)",
+		},
+		{
+			name:     "Ok None",
+			val:      result.Ok(option.None[int]()),
+			expected: "Ok(\n  None\n)",
+		},
+		{
+			name:     "Pair",
+			val:      gonads.Pair[string, option.Option[int]]{Key: "age", Value: option.Some(30)},
+			expected: "Pair(\n  \"age\"\n  Some(\n    30\n  )\n)",
+		},
+		{
+			name:     "Tuple",
+			val:      gonads.Tuple[int, option.Option[string]]{First: 1, Second: option.None[string]()},
+			expected: "Tuple(\n  1\n  None\n)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, gonads.Dump(test.val))
+		})
+	}
+}
+
+func TestDump_ErrorChain(t *testing.T) {
+	root := errors.New("not found")
+	wrapped := fmt.Errorf("load user: %w", root)
+	res := result.Error[string](wrapped)
+
+	expected := "Err(\n" +
+		"  load user: not found\n" +
+		"    caused by:\n" +
+		"      not found\n" +
+		")"
+	assert.Equal(t, expected, gonads.Dump(res))
+
+	joined := errors.Join(errors.New("a"), errors.New("b"))
+	expected = "Err(\n" +
+		"  a\n" +
+		"  b\n" +
+		"    caused by:\n" +
+		"      a\n" +
+		"    caused by:\n" +
+		"      b\n" +
+		")"
+	assert.Equal(t, expected, gonads.Dump(result.Error[int](joined)))
+}
+
+func TestDump_Composite(t *testing.T) {
+	type person struct {
+		Name     string
+		Nickname option.Option[string]
+		age      int
+	}
+
+	expected := "Some(\n" +
+		"  Ok(\n" +
+		"    gonads_test.person{\n" +
+		"      Name: \"Billy\"\n" +
+		"      Nickname: Some(\n" +
+		"        \"Bob\"\n" +
+		"      )\n" +
+		"    }\n" +
+		"  )\n" +
+		")"
+	assert.Equal(t, expected, gonads.Dump(option.Some(result.Ok(person{Name: "Billy", Nickname: option.Some("Bob"), age: 30}))))
+
+	expected = "[]option.Option[int]{\n" +
+		"  Some(\n" +
+		"    1\n" +
+		"  )\n" +
+		"  None\n" +
+		"}"
+	assert.Equal(t, expected, gonads.Dump([]option.Option[int]{option.Some(1), option.None[int]()}))
+
+	expected = "map[string]option.Option[int]{\n" +
+		"  \"a\": None\n" +
+		"  \"b\": Some(\n" +
+		"    2\n" +
+		"  )\n" +
+		"}"
+	assert.Equal(t, expected, gonads.Dump(map[string]option.Option[int]{"b": option.Some(2), "a": option.None[int]()}))
+
+	expected = "&gonads_test.person{\n" +
+		"  Name: \"Billy\"\n" +
+		"  Nickname: None\n" +
+		"}"
+	assert.Equal(t, expected, gonads.Dump(&person{Name: "Billy"}))
+
+	type node struct {
+		Next *node
+	}
+	n := &node{}
+	n.Next = n
+	assert.Contains(t, gonads.Dump(n), "...")
+}
+
+func TestDump_Secret(t *testing.T) {
+	type account struct {
+		User     string
+		Password option.Secret[string]
+	}
+
+	out := gonads.Dump(option.Some(account{User: "billy", Password: option.NewSecret("hunter2")}))
+	assert.Contains(t, out, "Password: ***")
+	assert.NotContains(t, out, "hunter2")
+}
+
+func TestDump_Cycles(t *testing.T) {
+	m := map[string]any{}
+	m["self"] = m
+	assert.Contains(t, gonads.Dump(m), "...")
+
+	s := make([]any, 1)
+	s[0] = s
+	assert.Contains(t, gonads.Dump(s), "...")
+}
+
+func TestFdump(t *testing.T) {
+	var sb strings.Builder
+	assert.NoError(t, gonads.Fdump(&sb, option.Some(1)))
+	assert.Equal(t, "Some(\n  1\n)\n", sb.String())
+}
@@ -0,0 +1,144 @@
+package gonads
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+)
+
+// DeepEqual reports whether a and b are deeply equal, similar to reflect.DeepEqual
+// but with an understanding of the types in this module:
+//
+//   - Options are equal if both are None, or both are Some with deeply equal values
+//   - Results are equal if both are Ok with deeply equal values, or both are Error
+//     with equal errors
+//   - Errors are equal if either matches the other using errors.Is, so a wrapped
+//     sentinel error is equal to the sentinel itself
+//
+// Slices, arrays, maps, pointers, and structs with only exported fields are
+// compared element by element using the same rules. Any other value, including
+// structs with unexported fields, is compared using reflect.DeepEqual.
+func DeepEqual(a, b any) bool {
+	return deepEqual(a, b, make(map[visit]bool))
+}
+
+type visit struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func deepEqual(a, b any, visited map[visit]bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		// Errors are commonly compared through the error interface where the
+		// concrete types differ, ie a wrapped error vs the sentinel.
+		errA, okA := a.(error)
+		errB, okB := b.(error)
+		return okA && okB && errorsEqual(errA, errB)
+	}
+
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if valA, okA, isOption := reflectopt.Option(ra); isOption {
+		valB, okB, _ := reflectopt.Option(rb)
+		if okA != okB {
+			return false
+		}
+		return !okA || deepEqual(valA.Interface(), valB.Interface(), visited)
+	}
+	if valA, errA, isResult := reflectopt.Result(ra); isResult {
+		valB, errB, _ := reflectopt.Result(rb)
+		if errA != nil || errB != nil {
+			return errA != nil && errB != nil && errorsEqual(errA, errB)
+		}
+		return deepEqual(valA.Interface(), valB.Interface(), visited)
+	}
+
+	switch va := a.(type) {
+	case pairLike:
+		keyA, valA := va.pair()
+		keyB, valB := b.(pairLike).pair()
+		return deepEqual(keyA, keyB, visited) && deepEqual(valA, valB, visited)
+	case tupleLike:
+		firstA, secondA := va.tuple()
+		firstB, secondB := b.(tupleLike).tuple()
+		return deepEqual(firstA, firstB, visited) && deepEqual(secondA, secondB, visited)
+	case error:
+		return errorsEqual(va, b.(error))
+	}
+
+	switch ra.Kind() {
+	case reflect.Pointer:
+		if ra.IsNil() || rb.IsNil() {
+			return ra.IsNil() && rb.IsNil()
+		}
+		if ra.Pointer() == rb.Pointer() || seen(visited, ra, rb) {
+			return true
+		}
+		return deepEqual(ra.Elem().Interface(), rb.Elem().Interface(), visited)
+	case reflect.Slice:
+		if ra.IsNil() != rb.IsNil() || ra.Len() != rb.Len() {
+			return false
+		}
+		if ra.Pointer() == rb.Pointer() || seen(visited, ra, rb) {
+			return true
+		}
+		fallthrough
+	case reflect.Array:
+		if ra.Len() != rb.Len() {
+			return false
+		}
+		for i := 0; i < ra.Len(); i++ {
+			if !deepEqual(ra.Index(i).Interface(), rb.Index(i).Interface(), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if ra.IsNil() != rb.IsNil() || ra.Len() != rb.Len() {
+			return false
+		}
+		if ra.Pointer() == rb.Pointer() || seen(visited, ra, rb) {
+			return true
+		}
+		iter := ra.MapRange()
+		for iter.Next() {
+			vb := rb.MapIndex(iter.Key())
+			if !vb.IsValid() || !deepEqual(iter.Value().Interface(), vb.Interface(), visited) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < ra.NumField(); i++ {
+			if !ra.Type().Field(i).IsExported() {
+				return reflect.DeepEqual(a, b)
+			}
+		}
+		for i := 0; i < ra.NumField(); i++ {
+			if !deepEqual(ra.Field(i).Interface(), rb.Field(i).Interface(), visited) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// seen records that the pointers, maps, or slices a and b are being compared,
+// reporting whether they already were. Like reflect.DeepEqual, a comparison that
+// is already in progress is assumed equal so cyclic values terminate.
+func seen(visited map[visit]bool, a, b reflect.Value) bool {
+	v := visit{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+	if visited[v] {
+		return true
+	}
+	visited[v] = true
+	return false
+}
+
+func errorsEqual(a, b error) bool {
+	return errors.Is(a, b) || errors.Is(b, a)
+}
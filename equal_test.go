@@ -0,0 +1,128 @@
+package gonads_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+var errNotFound = errors.New("not found")
+
+type record struct {
+	Name  string
+	Email option.Option[string]
+	Tags  []option.Option[string]
+}
+
+type node struct {
+	Val  int
+	Next *node
+}
+
+func TestDeepEqual(t *testing.T) {
+	cyclicA := &node{Val: 1}
+	cyclicA.Next = cyclicA
+	mapA := map[string]any{"val": 1}
+	mapA["self"] = mapA
+	mapB := map[string]any{"val": 1}
+	mapB["self"] = mapB
+	sliceA := []any{1, nil}
+	sliceA[1] = sliceA
+	sliceB := []any{1, nil}
+	sliceB[1] = sliceB
+	cyclicB := &node{Val: 1}
+	cyclicB.Next = cyclicB
+
+	tests := []struct {
+		name     string
+		a, b     any
+		expected bool
+	}{
+		{name: "Nil", a: nil, b: nil, expected: true},
+		{name: "Nil and Value", a: nil, b: 1, expected: false},
+		{name: "Different Types", a: 1, b: "1", expected: false},
+		{name: "None", a: option.None[int](), b: option.None[int](), expected: true},
+		{name: "Some Equal", a: option.Some(1), b: option.Some(1), expected: true},
+		{name: "Some Different", a: option.Some(1), b: option.Some(2), expected: false},
+		{name: "Some and None", a: option.Some(1), b: option.None[int](), expected: false},
+		{name: "Ok Equal", a: result.Ok("a"), b: result.Ok("a"), expected: true},
+		{name: "Ok and Error", a: result.Ok("a"), b: result.Error[string](errNotFound), expected: false},
+		{
+			name:     "Error Wrapped",
+			a:        result.Error[string](fmt.Errorf("load: %w", errNotFound)),
+			b:        result.Error[string](errNotFound),
+			expected: true,
+		},
+		{
+			name:     "Error Different",
+			a:        result.Error[string](errors.New("not found")),
+			b:        result.Error[string](errNotFound),
+			expected: false,
+		},
+		{
+			name:     "Nested Option Result",
+			a:        option.Some(result.Error[int](fmt.Errorf("wrapped: %w", errNotFound))),
+			b:        option.Some(result.Error[int](errNotFound)),
+			expected: true,
+		},
+		{
+			name:     "Struct With Options",
+			a:        record{Name: "Billy", Email: option.Some("b@example.com"), Tags: []option.Option[string]{option.None[string]()}},
+			b:        record{Name: "Billy", Email: option.Some("b@example.com"), Tags: []option.Option[string]{option.None[string]()}},
+			expected: true,
+		},
+		{
+			name:     "Struct With Different Options",
+			a:        record{Name: "Billy", Email: option.Some("b@example.com")},
+			b:        record{Name: "Billy", Email: option.None[string]()},
+			expected: false,
+		},
+		{
+			name:     "Map Of Results",
+			a:        map[string]result.Result[int]{"a": result.Error[int](fmt.Errorf("x: %w", errNotFound))},
+			b:        map[string]result.Result[int]{"a": result.Error[int](errNotFound)},
+			expected: true,
+		},
+		{
+			name:     "Pair",
+			a:        gonads.Pair[string, option.Option[int]]{Key: "a", Value: option.Some(1)},
+			b:        gonads.Pair[string, option.Option[int]]{Key: "a", Value: option.Some(1)},
+			expected: true,
+		},
+		{
+			name:     "Tuple",
+			a:        gonads.Tuple[int, result.Result[int]]{First: 1, Second: result.Error[int](fmt.Errorf("x: %w", errNotFound))},
+			b:        gonads.Tuple[int, result.Result[int]]{First: 1, Second: result.Error[int](errNotFound)},
+			expected: true,
+		},
+		{name: "Cyclic Pointers", a: cyclicA, b: cyclicB, expected: true},
+		{name: "Cyclic Maps", a: mapA, b: mapB, expected: true},
+		{name: "Cyclic Slices", a: sliceA, b: sliceB, expected: true},
+		{
+			name:     "Secrets",
+			a:        struct{ Password option.Secret[string] }{option.NewSecret("hunter2")},
+			b:        struct{ Password option.Secret[string] }{option.NewSecret("hunter2")},
+			expected: true,
+		},
+		{
+			name:     "Different Secrets",
+			a:        struct{ Password option.Secret[string] }{option.NewSecret("hunter2")},
+			b:        struct{ Password option.Secret[string] }{option.NewSecret("letmein")},
+			expected: false,
+		},
+		{name: "Errors", a: fmt.Errorf("x: %w", errNotFound), b: errNotFound, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, gonads.DeepEqual(test.a, test.b))
+			assert.Equal(t, test.expected, gonads.DeepEqual(test.b, test.a))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package errenum
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// Error is a sentinel error that is a member of an Enum. Every Error has a code
+// that uniquely identifies it within its Enum and a human-readable message.
+//
+// Errors are matched by identity, so wrapping an Error with fmt.Errorf and %w
+// preserves its code.
+type Error[C comparable] struct {
+	enum    *Enum[C]
+	code    C
+	message string
+}
+
+// Error returns the message of the Error.
+func (e *Error[C]) Error() string {
+	return e.message
+}
+
+// Code returns the code of the Error.
+func (e *Error[C]) Code() C {
+	return e.code
+}
+
+// Enum is a small closed set of sentinel errors, for example the errors a service
+// can return to its clients. Defining errors through an Enum gives them codes
+// that can be consistently extracted and mapped, ie to HTTP or gRPC status codes.
+//
+//	var (
+//		Errors      = errenum.New[string]("user")
+//		ErrNotFound = Errors.Define("NOT_FOUND", "user not found")
+//		ErrConflict = Errors.Define("CONFLICT", "user already exists")
+//	)
+//
+// Members should be defined during package initialization. The zero value isn't
+// usable and an Enum needs to be created with New.
+type Enum[C comparable] struct {
+	name    string
+	members []*Error[C]
+	byCode  map[C]*Error[C]
+}
+
+// New creates an empty Enum with the given name.
+func New[C comparable](name string) *Enum[C] {
+	return &Enum[C]{
+		name:   name,
+		byCode: make(map[C]*Error[C]),
+	}
+}
+
+// Name returns the name of the Enum.
+func (e *Enum[C]) Name() string {
+	return e.name
+}
+
+// Define adds a new member to the Enum. Define panics if the code is already
+// defined since that is a programming error.
+func (e *Enum[C]) Define(code C, message string) *Error[C] {
+	if _, exists := e.byCode[code]; exists {
+		panic(fmt.Sprintf("errenum: code %v is already defined in %s", code, e.name))
+	}
+	member := &Error[C]{enum: e, code: code, message: message}
+	e.members = append(e.members, member)
+	e.byCode[code] = member
+	return member
+}
+
+// Members returns every member of the Enum in the order they were defined.
+func (e *Enum[C]) Members() []*Error[C] {
+	members := make([]*Error[C], len(e.members))
+	copy(members, e.members)
+	return members
+}
+
+// Lookup returns the member with the given code, or None if the code isn't
+// defined.
+func (e *Enum[C]) Lookup(code C) option.Option[*Error[C]] {
+	member, ok := e.byCode[code]
+	if !ok {
+		return option.None[*Error[C]]()
+	}
+	return option.Some(member)
+}
+
+// Match returns the member of the Enum found in the error's tree, or None if the
+// error doesn't contain a member of this Enum.
+func (e *Enum[C]) Match(err error) option.Option[*Error[C]] {
+	var member *Error[C]
+	if errors.As(err, &member) && member.enum == e {
+		return option.Some(member)
+	}
+	return option.None[*Error[C]]()
+}
+
+// CodeOf returns the code of the first Error with a code of type C found in the
+// error of the Result. None is returned if the Result is Ok or its error doesn't
+// contain an Error with a code of type C.
+func CodeOf[C comparable, T any](res result.Result[T]) option.Option[C] {
+	_, err := res.Get()
+	var member *Error[C]
+	if err != nil && errors.As(err, &member) {
+		return option.Some(member.code)
+	}
+	return option.None[C]()
+}
+
+// SwitchErr matches err against the members of the Enum and invokes the case for
+// the matching member's code. If err doesn't contain a member of the Enum,
+// including when err is nil, otherwise is invoked.
+//
+// SwitchErr is exhaustive, it panics if cases doesn't contain a case for every
+// member of the Enum or if otherwise is nil. This catches members added to the
+// Enum without updating the code that handles them.
+func SwitchErr[C comparable, R any](err error, enum *Enum[C], cases map[C]func(err error) R, otherwise func(err error) R) R {
+	for _, member := range enum.members {
+		if _, ok := cases[member.code]; !ok {
+			panic(fmt.Sprintf("errenum: SwitchErr is missing a case for %s code %v", enum.name, member.code))
+		}
+	}
+	if otherwise == nil {
+		panic("errenum: SwitchErr requires an otherwise case")
+	}
+	if member, ok := enum.Match(err).Get(); ok {
+		return cases[member.code](err)
+	}
+	return otherwise(err)
+}
@@ -0,0 +1,81 @@
+package errenum
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+var (
+	userErrors  = New[string]("user")
+	errNotFound = userErrors.Define("NOT_FOUND", "user not found")
+	errConflict = userErrors.Define("CONFLICT", "user already exists")
+)
+
+func TestEnum_Define(t *testing.T) {
+	assert.Equal(t, "user", userErrors.Name())
+	assert.Equal(t, "NOT_FOUND", errNotFound.Code())
+	assert.Equal(t, "user not found", errNotFound.Error())
+	assert.Equal(t, []*Error[string]{errNotFound, errConflict}, userErrors.Members())
+
+	assert.Panics(t, func() {
+		userErrors.Define("NOT_FOUND", "duplicate")
+	})
+}
+
+func TestEnum_Lookup(t *testing.T) {
+	assert.Equal(t, option.Some(errConflict), userErrors.Lookup("CONFLICT"))
+	assert.True(t, userErrors.Lookup("UNKNOWN").IsNone())
+}
+
+func TestEnum_Match(t *testing.T) {
+	other := New[string]("other")
+	otherErr := other.Define("NOT_FOUND", "other not found")
+
+	wrapped := fmt.Errorf("load user 42: %w", errNotFound)
+	assert.Equal(t, option.Some(errNotFound), userErrors.Match(wrapped))
+	assert.True(t, userErrors.Match(otherErr).IsNone())
+	assert.True(t, userErrors.Match(errors.New("boom")).IsNone())
+	assert.True(t, userErrors.Match(nil).IsNone())
+}
+
+func TestCodeOf(t *testing.T) {
+	res := result.Error[int](fmt.Errorf("create: %w", errConflict))
+	assert.Equal(t, option.Some("CONFLICT"), CodeOf[string](res))
+	assert.True(t, CodeOf[string](result.Ok(1)).IsNone())
+	assert.True(t, CodeOf[string](result.Error[int](errors.New("boom"))).IsNone())
+	assert.True(t, CodeOf[int](res).IsNone())
+}
+
+func TestSwitchErr(t *testing.T) {
+	cases := map[string]func(error) int{
+		"NOT_FOUND": func(error) int { return http.StatusNotFound },
+		"CONFLICT":  func(error) int { return http.StatusConflict },
+	}
+	otherwise := func(err error) int {
+		if err == nil {
+			return http.StatusOK
+		}
+		return http.StatusInternalServerError
+	}
+
+	assert.Equal(t, http.StatusNotFound, SwitchErr(fmt.Errorf("x: %w", errNotFound), userErrors, cases, otherwise))
+	assert.Equal(t, http.StatusConflict, SwitchErr(errConflict, userErrors, cases, otherwise))
+	assert.Equal(t, http.StatusInternalServerError, SwitchErr(errors.New("boom"), userErrors, cases, otherwise))
+	assert.Equal(t, http.StatusOK, SwitchErr(nil, userErrors, cases, otherwise))
+
+	assert.Panics(t, func() {
+		SwitchErr(errNotFound, userErrors, map[string]func(error) int{
+			"NOT_FOUND": func(error) int { return http.StatusNotFound },
+		}, otherwise)
+	})
+	assert.Panics(t, func() {
+		SwitchErr(errNotFound, userErrors, cases, nil)
+	})
+}
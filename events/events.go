@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrHandlerPanic is the error contained in a Delivery when the subscriber
+// panicked while handling the event.
+var ErrHandlerPanic = errors.New("event handler panicked")
+
+// Handler handles an event published to a Bus.
+type Handler[T any] func(ctx context.Context, event T) result.Result[gonads.Unit]
+
+// Delivery is the outcome of delivering an event to a single subscriber.
+type Delivery[T any] struct {
+	Subscriber string
+	Event      T
+	Result     result.Result[gonads.Unit]
+	handler    Handler[T]
+}
+
+// Redeliver delivers the event to the subscriber again, returning the new Result.
+// Panics are recovered the same as Publish.
+//
+// Redeliver has the same shape as fn.Func allowing failed deliveries to be
+// retried using the decorators in the fn package or any other retry strategy.
+func (d Delivery[T]) Redeliver(ctx context.Context) result.Result[gonads.Unit] {
+	return deliver(ctx, d.handler, d.Event)
+}
+
+type subscription[T any] struct {
+	id      uint64
+	name    string
+	handler Handler[T]
+}
+
+// Bus is a typed in-process event bus. Events published to the Bus are
+// delivered to every subscriber concurrently and the outcome of every delivery
+// is reported back to the publisher.
+//
+// Bus is safe for concurrent use. The zero value isn't usable and a Bus needs to
+// be created with New.
+type Bus[T any] struct {
+	mu     sync.RWMutex
+	subs   []subscription[T]
+	nextID uint64
+}
+
+// New creates a new Bus with no subscribers.
+func New[T any]() *Bus[T] {
+	return &Bus[T]{}
+}
+
+// Subscribe registers a handler that will receive every event published after
+// Subscribe returns. The name identifies the subscriber in the Delivery results.
+// The returned function unsubscribes the handler.
+func (b *Bus[T]) Subscribe(name string, handler Handler[T]) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	id := b.nextID
+	b.subs = append(b.subs, subscription[T]{id: id, name: name, handler: handler})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, sub := range b.subs {
+			if sub.id == id {
+				b.subs = append(b.subs[:i:i], b.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Publish delivers the event to every subscriber concurrently and waits for all
+// of them to complete. A Delivery is returned for every subscriber in the order
+// they subscribed. A subscriber that panics doesn't affect the other subscribers,
+// the panic is recovered and reported as an Error Result wrapping
+// ErrHandlerPanic.
+func (b *Bus[T]) Publish(ctx context.Context, event T) []Delivery[T] {
+	b.mu.RLock()
+	subs := make([]subscription[T], len(b.subs))
+	copy(subs, b.subs)
+	b.mu.RUnlock()
+
+	deliveries := make([]Delivery[T], len(subs))
+	var wg sync.WaitGroup
+	wg.Add(len(subs))
+	for i, sub := range subs {
+		go func(i int, sub subscription[T]) {
+			defer wg.Done()
+			deliveries[i] = Delivery[T]{
+				Subscriber: sub.name,
+				Event:      event,
+				Result:     deliver(ctx, sub.handler, event),
+				handler:    sub.handler,
+			}
+		}(i, sub)
+	}
+	wg.Wait()
+	return deliveries
+}
+
+// Failed returns the deliveries that resulted in an error.
+func Failed[T any](deliveries []Delivery[T]) []Delivery[T] {
+	failed := make([]Delivery[T], 0)
+	for _, d := range deliveries {
+		if d.Result.IsErr() {
+			failed = append(failed, d)
+		}
+	}
+	return failed
+}
+
+func deliver[T any](ctx context.Context, handler Handler[T], event T) (res result.Result[gonads.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = result.Error[gonads.Unit](fmt.Errorf("%w: %v", ErrHandlerPanic, r))
+		}
+	}()
+	return handler(ctx, event)
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/result"
+)
+
+func TestBus_Publish(t *testing.T) {
+	testErr := errors.New("test error")
+	bus := New[string]()
+
+	var received atomic.Int32
+	bus.Subscribe("ok", func(ctx context.Context, event string) result.Result[gonads.Unit] {
+		received.Add(1)
+		return result.Ok(gonads.Unit{})
+	})
+	bus.Subscribe("err", func(ctx context.Context, event string) result.Result[gonads.Unit] {
+		received.Add(1)
+		return result.Error[gonads.Unit](testErr)
+	})
+	bus.Subscribe("panic", func(ctx context.Context, event string) result.Result[gonads.Unit] {
+		panic("boom")
+	})
+
+	deliveries := bus.Publish(context.Background(), "user.created")
+	assert.Len(t, deliveries, 3)
+	assert.Equal(t, int32(2), received.Load())
+
+	assert.Equal(t, "ok", deliveries[0].Subscriber)
+	assert.Equal(t, "user.created", deliveries[0].Event)
+	assert.True(t, deliveries[0].Result.IsOk())
+
+	_, err := deliveries[1].Result.Get()
+	assert.ErrorIs(t, err, testErr)
+
+	_, err = deliveries[2].Result.Get()
+	assert.ErrorIs(t, err, ErrHandlerPanic)
+	assert.Contains(t, err.Error(), "boom")
+
+	failed := Failed(deliveries)
+	assert.Len(t, failed, 2)
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	bus := New[int]()
+	unsubscribe := bus.Subscribe("a", func(ctx context.Context, event int) result.Result[gonads.Unit] {
+		return result.Ok(gonads.Unit{})
+	})
+	bus.Subscribe("b", func(ctx context.Context, event int) result.Result[gonads.Unit] {
+		return result.Ok(gonads.Unit{})
+	})
+
+	unsubscribe()
+	unsubscribe()
+	deliveries := bus.Publish(context.Background(), 1)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, "b", deliveries[0].Subscriber)
+
+	assert.Empty(t, New[int]().Publish(context.Background(), 1))
+}
+
+func TestDelivery_Redeliver(t *testing.T) {
+	bus := New[int]()
+	attempts := 0
+	bus.Subscribe("flaky", func(ctx context.Context, event int) result.Result[gonads.Unit] {
+		attempts++
+		if attempts < 2 {
+			return result.Error[gonads.Unit](errors.New("transient"))
+		}
+		return result.Ok(gonads.Unit{})
+	})
+
+	deliveries := bus.Publish(context.Background(), 1)
+	failed := Failed(deliveries)
+	assert.Len(t, failed, 1)
+	assert.True(t, failed[0].Redeliver(context.Background()).IsOk())
+	assert.Equal(t, 2, attempts)
+}
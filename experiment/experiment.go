@@ -0,0 +1,158 @@
+package experiment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/fn"
+	"github.com/jkratz55/gonads/result"
+)
+
+var (
+	// ErrCandidatePanic is the error of the candidate Result when the candidate
+	// panicked.
+	ErrCandidatePanic = errors.New("candidate panicked")
+	// ErrCandidateTimeout is the error of the candidate Result when the candidate
+	// didn't complete within the Timeout.
+	ErrCandidateTimeout = errors.New("candidate timed out")
+)
+
+const defaultTimeout = 100 * time.Millisecond
+
+// Kind describes how the candidate diverged from the control.
+type Kind int
+
+const (
+	// ValueMismatch indicates both succeeded but produced different values.
+	ValueMismatch Kind = iota
+	// ErrorMismatch indicates one of the control and candidate failed while the
+	// other succeeded.
+	ErrorMismatch
+	// TimeoutMismatch indicates the candidate didn't complete within the Timeout.
+	TimeoutMismatch
+)
+
+// String returns the name of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case ValueMismatch:
+		return "value mismatch"
+	case ErrorMismatch:
+		return "error mismatch"
+	case TimeoutMismatch:
+		return "timeout"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Mismatch is reported when the candidate diverges from the control.
+type Mismatch[T any] struct {
+	Name      string
+	Kind      Kind
+	Control   result.Result[T]
+	Candidate result.Result[T]
+}
+
+// Options configures an experiment.
+type Options[T any] struct {
+	// Name identifies the experiment in reported Mismatches.
+	Name string
+	// Equal compares the values of the control and candidate when both succeed.
+	// Defaults to gonads.DeepEqual.
+	Equal func(control, candidate T) bool
+	// Timeout is the maximum amount of time to wait for the candidate after the
+	// control completes before reporting a TimeoutMismatch. Defaults to 100ms.
+	Timeout time.Duration
+	// OnMismatch is invoked when the candidate diverges from the control. It is
+	// invoked on a separate goroutine, usually after the result of the control
+	// has been returned, and must be safe for concurrent use.
+	OnMismatch func(Mismatch[T])
+}
+
+// Compare returns a Func that runs control and candidate concurrently and always
+// returns the Result of control, reporting any divergence of candidate to the
+// OnMismatch hook. Compare allows a new implementation to be verified against
+// production traffic before it replaces the current one.
+//
+// Both failing is not considered a mismatch. The control runs on the calling
+// goroutine so its panics propagate to the caller, while panics in the candidate
+// are recovered and reported as an error mismatch. The Result of the control is
+// returned as soon as the control completes; waiting for the candidate,
+// comparing and reporting happen in the background so the candidate never adds
+// latency.
+func Compare[T any](control, candidate fn.Func[T], opts Options[T]) fn.Func[T] {
+	if opts.Equal == nil {
+		opts.Equal = func(control, candidate T) bool {
+			return gonads.DeepEqual(control, candidate)
+		}
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	return func(ctx context.Context) result.Result[T] {
+		done := make(chan result.Result[T], 1)
+		go func() {
+			done <- runCandidate(ctx, candidate)
+		}()
+
+		controlRes := control(ctx)
+		go verify(opts, controlRes, done)
+		return controlRes
+	}
+}
+
+// verify waits up to the Timeout for the Result of the candidate and reports if
+// it diverges from the Result of the control.
+func verify[T any](opts Options[T], controlRes result.Result[T], done <-chan result.Result[T]) {
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+
+	select {
+	case candidateRes := <-done:
+		if kind, mismatch := compare(controlRes, candidateRes, opts.Equal); mismatch {
+			report(opts, kind, controlRes, candidateRes)
+		}
+	case <-timer.C:
+		report(opts, TimeoutMismatch, controlRes, result.Error[T](ErrCandidateTimeout))
+	}
+}
+
+func runCandidate[T any](ctx context.Context, candidate fn.Func[T]) (res result.Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = result.Error[T](fmt.Errorf("%w: %v", ErrCandidatePanic, r))
+		}
+	}()
+	return candidate(ctx)
+}
+
+func compare[T any](control, candidate result.Result[T], equal func(T, T) bool) (Kind, bool) {
+	controlVal, controlErr := control.Get()
+	candidateVal, candidateErr := candidate.Get()
+	switch {
+	case controlErr != nil && candidateErr != nil:
+		return 0, false
+	case controlErr != nil || candidateErr != nil:
+		return ErrorMismatch, true
+	case !equal(controlVal, candidateVal):
+		return ValueMismatch, true
+	default:
+		return 0, false
+	}
+}
+
+func report[T any](opts Options[T], kind Kind, control, candidate result.Result[T]) {
+	if opts.OnMismatch == nil {
+		return
+	}
+	opts.OnMismatch(Mismatch[T]{
+		Name:      opts.Name,
+		Kind:      kind,
+		Control:   control,
+		Candidate: candidate,
+	})
+}
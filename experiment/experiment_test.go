@@ -0,0 +1,182 @@
+package experiment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/fn"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+func constant[T any](res result.Result[T]) fn.Func[T] {
+	return func(ctx context.Context) result.Result[T] {
+		return res
+	}
+}
+
+// mismatches returns an OnMismatch hook sending the reported Mismatches to the
+// returned channel.
+func mismatches[T any]() (func(Mismatch[T]), <-chan Mismatch[T]) {
+	ch := make(chan Mismatch[T], 1)
+	return func(m Mismatch[T]) { ch <- m }, ch
+}
+
+func awaitMismatch[T any](t *testing.T, ch <-chan Mismatch[T]) Mismatch[T] {
+	t.Helper()
+	select {
+	case m := <-ch:
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("mismatch wasn't reported")
+		return Mismatch[T]{}
+	}
+}
+
+func assertNoMismatch[T any](t *testing.T, ch <-chan Mismatch[T]) {
+	t.Helper()
+	select {
+	case m := <-ch:
+		t.Fatalf("unexpected mismatch: %v", m.Kind)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCompare(t *testing.T) {
+	testErr := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		control   result.Result[int]
+		candidate result.Result[int]
+		expected  option.Option[Kind]
+	}{
+		{name: "Match", control: result.Ok(1), candidate: result.Ok(1)},
+		{name: "Both Failed", control: result.Error[int](testErr), candidate: result.Error[int](errors.New("other"))},
+		{name: "Value Mismatch", control: result.Ok(1), candidate: result.Ok(2), expected: option.Some(ValueMismatch)},
+		{name: "Candidate Failed", control: result.Ok(1), candidate: result.Error[int](testErr), expected: option.Some(ErrorMismatch)},
+		{name: "Control Failed", control: result.Error[int](testErr), candidate: result.Ok(1), expected: option.Some(ErrorMismatch)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			onMismatch, reported := mismatches[int]()
+			call := Compare(constant(test.control), constant(test.candidate), Options[int]{
+				Name:       "test",
+				OnMismatch: onMismatch,
+			})
+			assert.Equal(t, test.control, call(context.Background()))
+
+			kind, ok := test.expected.Get()
+			if !ok {
+				assertNoMismatch(t, reported)
+				return
+			}
+			m := awaitMismatch(t, reported)
+			assert.Equal(t, "test", m.Name)
+			assert.Equal(t, kind, m.Kind)
+			assert.Equal(t, test.control, m.Control)
+			assert.Equal(t, test.candidate, m.Candidate)
+		})
+	}
+}
+
+func TestCompare_CustomEqual(t *testing.T) {
+	onMismatch, reported := mismatches[float64]()
+	call := Compare(constant(result.Ok(1.0)), constant(result.Ok(1.001)), Options[float64]{
+		Equal: func(control, candidate float64) bool {
+			return candidate-control < 0.01
+		},
+		OnMismatch: onMismatch,
+	})
+	assert.Equal(t, result.Ok(1.0), call(context.Background()))
+	assertNoMismatch(t, reported)
+}
+
+func TestCompare_CandidatePanic(t *testing.T) {
+	candidate := func(ctx context.Context) result.Result[int] {
+		panic("oops")
+	}
+	onMismatch, reported := mismatches[int]()
+	call := Compare(constant(result.Ok(1)), candidate, Options[int]{
+		OnMismatch: onMismatch,
+	})
+
+	assert.Equal(t, result.Ok(1), call(context.Background()))
+	mismatch := awaitMismatch(t, reported)
+	assert.Equal(t, ErrorMismatch, mismatch.Kind)
+	assert.ErrorIs(t, mismatch.Candidate.Error().Unwrap(), ErrCandidatePanic)
+}
+
+func TestCompare_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	candidate := func(ctx context.Context) result.Result[int] {
+		<-release
+		return result.Ok(1)
+	}
+
+	onMismatch, reported := mismatches[int]()
+	call := Compare(constant(result.Ok(1)), candidate, Options[int]{
+		Timeout:    10 * time.Millisecond,
+		OnMismatch: onMismatch,
+	})
+
+	assert.Equal(t, result.Ok(1), call(context.Background()))
+	mismatch := awaitMismatch(t, reported)
+	assert.Equal(t, TimeoutMismatch, mismatch.Kind)
+	assert.ErrorIs(t, mismatch.Candidate.Error().Unwrap(), ErrCandidateTimeout)
+}
+
+func TestCompare_DoesNotWaitForCandidate(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	candidate := func(ctx context.Context) result.Result[int] {
+		<-release
+		return result.Ok(1)
+	}
+
+	onMismatch, reported := mismatches[int]()
+	call := Compare(constant(result.Ok(1)), candidate, Options[int]{
+		Timeout:    time.Hour,
+		OnMismatch: onMismatch,
+	})
+
+	done := make(chan result.Result[int], 1)
+	go func() { done <- call(context.Background()) }()
+	select {
+	case res := <-done:
+		assert.Equal(t, result.Ok(1), res)
+	case <-time.After(5 * time.Second):
+		t.Fatal("control result was held up by the blocked candidate")
+	}
+	assertNoMismatch(t, reported)
+}
+
+func TestCompare_DefaultTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	candidate := func(ctx context.Context) result.Result[int] {
+		<-release
+		return result.Ok(1)
+	}
+
+	onMismatch, reported := mismatches[int]()
+	call := Compare(constant(result.Ok(1)), candidate, Options[int]{
+		OnMismatch: onMismatch,
+	})
+
+	assert.Equal(t, result.Ok(1), call(context.Background()))
+	assert.Equal(t, TimeoutMismatch, awaitMismatch(t, reported).Kind)
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "value mismatch", ValueMismatch.String())
+	assert.Equal(t, "error mismatch", ErrorMismatch.String())
+	assert.Equal(t, "timeout", TimeoutMismatch.String())
+	assert.Equal(t, "Kind(42)", Kind(42).String())
+}
@@ -0,0 +1,180 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jkratz55/gonads/fn"
+	"github.com/jkratz55/gonads/result"
+)
+
+// now is the clock used for demotion cooldowns, it is replaced in tests.
+var now = time.Now
+
+var (
+	// ErrAllFailed is returned when every provider in the Chain failed.
+	ErrAllFailed = errors.New("all providers failed")
+	// ErrNoProviders is returned when the Chain has no registered providers.
+	ErrNoProviders = errors.New("no providers registered")
+)
+
+const (
+	defaultFailureThreshold = 3
+	defaultCooldown         = 30 * time.Second
+)
+
+// Options configures the health tracking of a Chain.
+type Options struct {
+	// FailureThreshold is the number of consecutive failures after which a
+	// provider is demoted. Defaults to 3.
+	FailureThreshold int
+	// Cooldown is how long a provider stays demoted before being restored to its
+	// normal priority. Defaults to 30 seconds.
+	Cooldown time.Duration
+}
+
+// Status is a snapshot of the health of a provider.
+type Status struct {
+	Name                string
+	Priority            int
+	ConsecutiveFailures int
+	Demoted             bool
+}
+
+type provider[T any] struct {
+	name         string
+	priority     int
+	seq          int
+	fn           fn.Func[T]
+	failures     int
+	demotedUntil time.Time
+}
+
+func (p *provider[T]) demoted(t time.Time) bool {
+	return t.Before(p.demotedUntil)
+}
+
+// Chain tries multiple providers of a value in priority order until one
+// succeeds. Each provider's recent failures are tracked, and providers that fail
+// repeatedly are temporarily demoted behind the healthy providers, so a
+// degraded region or vendor doesn't add latency to every call. Demoted providers
+// are still tried as a last resort.
+//
+// Chain is safe for concurrent use. The zero value isn't usable and a Chain needs
+// to be created with New.
+type Chain[T any] struct {
+	mu               sync.Mutex
+	providers        []*provider[T]
+	failureThreshold int
+	cooldown         time.Duration
+}
+
+// New creates a new Chain with no providers.
+func New[T any](opts Options) *Chain[T] {
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = defaultFailureThreshold
+	}
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = defaultCooldown
+	}
+	return &Chain[T]{
+		failureThreshold: opts.FailureThreshold,
+		cooldown:         opts.Cooldown,
+	}
+}
+
+// Register adds a provider to the Chain. Providers with a lower priority value are
+// tried first, providers with the same priority are tried in the order they were
+// registered.
+func (c *Chain[T]) Register(name string, priority int, provide fn.Func[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.providers = append(c.providers, &provider[T]{
+		name:     name,
+		priority: priority,
+		seq:      len(c.providers),
+		fn:       provide,
+	})
+}
+
+// Do invokes the providers in order until one returns an Ok Result. Healthy
+// providers are tried before demoted providers. If every provider fails an Error
+// Result wrapping ErrAllFailed and each provider's error is returned. If the
+// context is done between attempts the context error is returned.
+func (c *Chain[T]) Do(ctx context.Context) result.Result[T] {
+	order := c.order()
+	if len(order) == 0 {
+		return result.Error[T](ErrNoProviders)
+	}
+
+	errs := make([]error, 0, len(order))
+	for _, p := range order {
+		if err := ctx.Err(); err != nil {
+			return result.Error[T](err)
+		}
+		res := p.fn(ctx)
+		c.record(p, res.IsOk())
+		if res.IsOk() {
+			return res
+		}
+		_, err := res.Get()
+		errs = append(errs, fmt.Errorf("%s: %w", p.name, err))
+	}
+	return result.Error[T](fmt.Errorf("%w: %w", ErrAllFailed, errors.Join(errs...)))
+}
+
+// Health returns the status of every provider in the order they would currently
+// be tried.
+func (c *Chain[T]) Health() []Status {
+	order := c.order()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := now()
+	statuses := make([]Status, 0, len(order))
+	for _, p := range order {
+		statuses = append(statuses, Status{
+			Name:                p.name,
+			Priority:            p.priority,
+			ConsecutiveFailures: p.failures,
+			Demoted:             p.demoted(t),
+		})
+	}
+	return statuses
+}
+
+func (c *Chain[T]) order() []*provider[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := now()
+	order := make([]*provider[T], len(c.providers))
+	copy(order, c.providers)
+	sort.SliceStable(order, func(i, j int) bool {
+		di, dj := order[i].demoted(t), order[j].demoted(t)
+		if di != dj {
+			return !di
+		}
+		if order[i].priority != order[j].priority {
+			return order[i].priority < order[j].priority
+		}
+		return order[i].seq < order[j].seq
+	})
+	return order
+}
+
+func (c *Chain[T]) record(p *provider[T], ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ok {
+		p.failures = 0
+		p.demotedUntil = time.Time{}
+		return
+	}
+	p.failures++
+	if p.failures >= c.failureThreshold {
+		p.demotedUntil = now().Add(c.cooldown)
+	}
+}
@@ -0,0 +1,120 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	clock := &fakeClock{t: time.Now()}
+	now = clock.now
+	t.Cleanup(func() {
+		now = time.Now
+	})
+	return clock
+}
+
+type fakeProvider struct {
+	val   string
+	fail  bool
+	calls int
+}
+
+func (p *fakeProvider) provide(ctx context.Context) result.Result[string] {
+	p.calls++
+	if p.fail {
+		return result.Error[string](errors.New(p.val + " unavailable"))
+	}
+	return result.Ok(p.val)
+}
+
+func TestChain_Do(t *testing.T) {
+	primary := &fakeProvider{val: "primary"}
+	secondary := &fakeProvider{val: "secondary"}
+
+	chain := New[string](Options{})
+	chain.Register("secondary", 2, secondary.provide)
+	chain.Register("primary", 1, primary.provide)
+
+	assert.Equal(t, "primary", chain.Do(context.Background()).Unwrap())
+	assert.Equal(t, 1, primary.calls)
+	assert.Equal(t, 0, secondary.calls)
+
+	primary.fail = true
+	assert.Equal(t, "secondary", chain.Do(context.Background()).Unwrap())
+	assert.Equal(t, 2, primary.calls)
+	assert.Equal(t, 1, secondary.calls)
+}
+
+func TestChain_AllFailed(t *testing.T) {
+	chain := New[string](Options{})
+	_, err := chain.Do(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrNoProviders)
+
+	chain.Register("a", 1, (&fakeProvider{val: "a", fail: true}).provide)
+	chain.Register("b", 2, (&fakeProvider{val: "b", fail: true}).provide)
+	_, err = chain.Do(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrAllFailed)
+	assert.Contains(t, err.Error(), "a unavailable")
+	assert.Contains(t, err.Error(), "b unavailable")
+}
+
+func TestChain_Demotion(t *testing.T) {
+	clock := useFakeClock(t)
+	primary := &fakeProvider{val: "primary", fail: true}
+	secondary := &fakeProvider{val: "secondary"}
+
+	chain := New[string](Options{FailureThreshold: 2, Cooldown: time.Minute})
+	chain.Register("primary", 1, primary.provide)
+	chain.Register("secondary", 2, secondary.provide)
+
+	chain.Do(context.Background())
+	chain.Do(context.Background())
+	assert.Equal(t, 2, primary.calls)
+
+	health := chain.Health()
+	assert.Equal(t, "secondary", health[0].Name)
+	assert.Equal(t, Status{Name: "primary", Priority: 1, ConsecutiveFailures: 2, Demoted: true}, health[1])
+
+	// Primary is demoted so secondary is tried first
+	assert.Equal(t, "secondary", chain.Do(context.Background()).Unwrap())
+	assert.Equal(t, 2, primary.calls)
+
+	// Demoted providers are still tried as a last resort
+	secondary.fail = true
+	assert.True(t, chain.Do(context.Background()).IsErr())
+	assert.Equal(t, 3, primary.calls)
+
+	// After the cooldown the primary is restored and recovers
+	secondary.fail = false
+	primary.fail = false
+	clock.t = clock.t.Add(time.Minute)
+	assert.Equal(t, "primary", chain.Do(context.Background()).Unwrap())
+	assert.Equal(t, 0, chain.Health()[0].ConsecutiveFailures)
+}
+
+func TestChain_ContextDone(t *testing.T) {
+	chain := New[string](Options{})
+	p := &fakeProvider{val: "a"}
+	chain.Register("a", 1, p.provide)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := chain.Do(ctx).Get()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, p.calls)
+}
@@ -0,0 +1,145 @@
+package flags
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// Provider provides the values of feature flags. A flag that isn't set is
+// represented as None rather than the zero value, so "unset" and "set to false"
+// can be told apart.
+type Provider[T any] interface {
+	Get(ctx context.Context, key string) option.Option[T]
+}
+
+// GetOr returns the value of the flag from the Provider, or the default value if
+// the flag isn't set.
+func GetOr[T any](ctx context.Context, p Provider[T], key string, def T) T {
+	return p.Get(ctx, key).UnwrapOrDefault(def)
+}
+
+// Memory is an in-memory Provider, useful for tests and for flags managed by the
+// application itself.
+//
+// Memory is safe for concurrent use. The zero value isn't usable and a Memory
+// needs to be created with NewMemory.
+type Memory[T any] struct {
+	mu    sync.RWMutex
+	flags map[string]T
+}
+
+// NewMemory creates a Memory Provider with the initial flags. The map is copied.
+func NewMemory[T any](initial map[string]T) *Memory[T] {
+	flags := make(map[string]T, len(initial))
+	for k, v := range initial {
+		flags[k] = v
+	}
+	return &Memory[T]{flags: flags}
+}
+
+// Get returns Some with the value of the flag, or None if it isn't set.
+func (m *Memory[T]) Get(_ context.Context, key string) option.Option[T] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return lookup(m.flags, key)
+}
+
+// GetOr returns the value of the flag, or the default value if it isn't set.
+func (m *Memory[T]) GetOr(ctx context.Context, key string, def T) T {
+	return GetOr[T](ctx, m, key, def)
+}
+
+// Set sets the value of the flag.
+func (m *Memory[T]) Set(key string, val T) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.flags[key] = val
+}
+
+// Unset removes the flag so it is no longer set.
+func (m *Memory[T]) Unset(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.flags, key)
+}
+
+// JSONFile is a Provider backed by a JSON file containing an object mapping flag
+// keys to values. The file is read when the JSONFile is loaded and again each
+// time Reload is called.
+//
+// JSONFile is safe for concurrent use. The zero value isn't usable and a
+// JSONFile needs to be created with LoadJSONFile.
+type JSONFile[T any] struct {
+	path  string
+	mu    sync.RWMutex
+	flags map[string]T
+}
+
+// LoadJSONFile creates a JSONFile Provider reading the flags from the file at
+// path. An Error Result is returned if the file can't be read or parsed.
+func LoadJSONFile[T any](path string) result.Result[*JSONFile[T]] {
+	f := &JSONFile[T]{path: path}
+	if err := f.Reload(); err != nil {
+		return result.Error[*JSONFile[T]](err)
+	}
+	return result.Ok(f)
+}
+
+// Reload reads the flags from the file again. If the file can't be read or
+// parsed the previously loaded flags are kept and the error is returned.
+func (f *JSONFile[T]) Reload() error {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return fmt.Errorf("read flags file: %w", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("parse flags file %s: %w", f.path, err)
+	}
+	// Flags set to null are treated as unset rather than decoded as the zero
+	// value of T.
+	flags := make(map[string]T, len(raw))
+	for key, msg := range raw {
+		if bytes.Equal(bytes.TrimSpace(msg), []byte("null")) {
+			continue
+		}
+		var val T
+		if err := json.Unmarshal(msg, &val); err != nil {
+			return fmt.Errorf("parse flag %s in flags file %s: %w", key, f.path, err)
+		}
+		flags[key] = val
+	}
+	f.mu.Lock()
+	f.flags = flags
+	f.mu.Unlock()
+	return nil
+}
+
+// Get returns Some with the value of the flag, or None if it isn't set or is
+// null.
+func (f *JSONFile[T]) Get(_ context.Context, key string) option.Option[T] {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return lookup(f.flags, key)
+}
+
+// GetOr returns the value of the flag, or the default value if it isn't set.
+func (f *JSONFile[T]) GetOr(ctx context.Context, key string, def T) T {
+	return GetOr[T](ctx, f, key, def)
+}
+
+func lookup[T any](flags map[string]T, key string) option.Option[T] {
+	val, ok := flags[key]
+	if !ok {
+		return option.None[T]()
+	}
+	// A Memory flag can be explicitly set to a nil value.
+	return option.SomeNillable(val)
+}
@@ -0,0 +1,72 @@
+package flags
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	initial := map[string]bool{"dark-mode": false}
+	m := NewMemory(initial)
+	initial["beta"] = true
+
+	assert.Equal(t, option.Some(false), m.Get(ctx, "dark-mode"))
+	assert.True(t, m.Get(ctx, "beta").IsNone())
+	assert.True(t, m.GetOr(ctx, "beta", true))
+	assert.False(t, m.GetOr(ctx, "dark-mode", true))
+
+	m.Set("beta", true)
+	assert.Equal(t, option.Some(true), m.Get(ctx, "beta"))
+	m.Unset("beta")
+	assert.True(t, m.Get(ctx, "beta").IsNone())
+
+	var p Provider[bool] = m
+	assert.True(t, GetOr(ctx, p, "missing", true))
+}
+
+func TestJSONFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "flags.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"max-items": 10}`), 0o600))
+
+	f := LoadJSONFile[int](path).Unwrap()
+	assert.Equal(t, option.Some(10), f.Get(ctx, "max-items"))
+	assert.Equal(t, 5, f.GetOr(ctx, "page-size", 5))
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"max-items": 20, "page-size": 50}`), 0o600))
+	assert.NoError(t, f.Reload())
+	assert.Equal(t, option.Some(20), f.Get(ctx, "max-items"))
+	assert.Equal(t, 50, f.GetOr(ctx, "page-size", 5))
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"max-items": "oops"}`), 0o600))
+	assert.Error(t, f.Reload())
+	assert.Equal(t, option.Some(20), f.Get(ctx, "max-items"))
+}
+
+func TestJSONFile_Null(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "flags.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"alpha": true, "beta": null}`), 0o600))
+
+	f := LoadJSONFile[any](path).Unwrap()
+	assert.Equal(t, option.Some[any](true), f.Get(ctx, "alpha"))
+	assert.Equal(t, option.None[any](), f.Get(ctx, "beta"))
+	assert.Equal(t, any("off"), f.GetOr(ctx, "beta", "off"))
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"x": null, "y": 0}`), 0o600))
+	ints := LoadJSONFile[int](path).Unwrap()
+	assert.Equal(t, option.None[int](), ints.Get(ctx, "x"))
+	assert.Equal(t, option.Some(0), ints.Get(ctx, "y"))
+	assert.Equal(t, 5, ints.GetOr(ctx, "x", 5))
+}
+
+func TestLoadJSONFile_Error(t *testing.T) {
+	assert.True(t, LoadJSONFile[int](filepath.Join(t.TempDir(), "missing.json")).IsErr())
+}
@@ -0,0 +1,141 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrOverload is returned when a call is rejected because the concurrency limit
+// of an Adaptive Func has been reached.
+var ErrOverload = errors.New("overloaded")
+
+const (
+	defaultInitialLimit = 10
+	defaultMinLimit     = 1
+	defaultMaxLimit     = 1000
+	defaultBackoff      = 0.9
+)
+
+// AdaptiveOptions configures the concurrency limit of Adaptive.
+type AdaptiveOptions struct {
+	// InitialLimit is the concurrency limit before any calls are observed.
+	// Defaults to 10.
+	InitialLimit int
+	// MinLimit is the lowest the concurrency limit can be decreased to. Defaults
+	// to 1.
+	MinLimit int
+	// MaxLimit is the highest the concurrency limit can be increased to. Defaults
+	// to 1000.
+	MaxLimit int
+	// LatencyThreshold is the latency above which a successful call is treated as
+	// a sign of congestion. Zero disables latency based decreases so only errors
+	// decrease the limit.
+	LatencyThreshold time.Duration
+	// Backoff is the factor the limit is multiplied by when congestion is
+	// observed, between 0 and 1. Defaults to 0.9.
+	Backoff float64
+}
+
+// Adaptive decorates fn with a concurrency limit that adapts to the observed
+// latency and error rate of fn using AIMD (additive increase, multiplicative
+// decrease). Each call that succeeds within the LatencyThreshold increases the
+// limit by roughly one per limit calls, while each failed or slow call multiplies
+// the limit by Backoff. Calls made while the number of in-flight calls is at the
+// limit are rejected immediately with ErrOverload rather than queueing, which
+// sheds excess load before it reaches a struggling dependency.
+//
+// Errors caused by the context being cancelled aren't treated as congestion. If
+// fn panics its slot is released and the panic is treated as congestion before
+// it propagates to the caller.
+func Adaptive[T any](fn Func[T], opts AdaptiveOptions) Func[T] {
+	limiter := newAdaptiveLimiter(opts)
+	return func(ctx context.Context) result.Result[T] {
+		if !limiter.acquire() {
+			return result.Error[T](ErrOverload)
+		}
+		start := now()
+		completed := false
+		defer func() {
+			if !completed {
+				// fn panicked, free its slot and treat it as congestion before
+				// the panic continues.
+				limiter.release(true, now().Sub(start))
+			}
+		}()
+		res := fn(ctx)
+		completed = true
+		err := res.Error().UnwrapOrDefault(nil)
+		congested := err != nil && !errors.Is(err, context.Canceled)
+		limiter.release(congested, now().Sub(start))
+		return res
+	}
+}
+
+type adaptiveLimiter struct {
+	mu        sync.Mutex
+	limit     float64
+	inflight  int
+	min       float64
+	max       float64
+	threshold time.Duration
+	backoff   float64
+}
+
+func newAdaptiveLimiter(opts AdaptiveOptions) *adaptiveLimiter {
+	if opts.MinLimit <= 0 {
+		opts.MinLimit = defaultMinLimit
+	}
+	if opts.MaxLimit <= 0 {
+		opts.MaxLimit = defaultMaxLimit
+	}
+	if opts.MaxLimit < opts.MinLimit {
+		opts.MaxLimit = opts.MinLimit
+	}
+	if opts.InitialLimit <= 0 {
+		opts.InitialLimit = defaultInitialLimit
+	}
+	if opts.Backoff <= 0 || opts.Backoff >= 1 {
+		opts.Backoff = defaultBackoff
+	}
+	initial := min(max(opts.InitialLimit, opts.MinLimit), opts.MaxLimit)
+	return &adaptiveLimiter{
+		limit:     float64(initial),
+		min:       float64(opts.MinLimit),
+		max:       float64(opts.MaxLimit),
+		threshold: opts.LatencyThreshold,
+		backoff:   opts.Backoff,
+	}
+}
+
+// acquire reserves a slot for a call, returning false if the limit is reached.
+func (l *adaptiveLimiter) acquire() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inflight >= l.current() {
+		return false
+	}
+	l.inflight++
+	return true
+}
+
+// release frees the slot of a call and adjusts the limit based on its outcome.
+func (l *adaptiveLimiter) release(failed bool, latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight--
+	if failed || (l.threshold > 0 && latency > l.threshold) {
+		l.limit = max(l.limit*l.backoff, l.min)
+		return
+	}
+	l.limit = min(l.limit+1/l.limit, l.max)
+}
+
+// current returns the limit as a whole number of calls. The lock must be held.
+func (l *adaptiveLimiter) current() int {
+	return int(math.Floor(l.limit))
+}
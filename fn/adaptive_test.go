@@ -0,0 +1,113 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+func TestAdaptive_RejectsOverLimit(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	call := Adaptive(func(ctx context.Context) result.Result[int] {
+		started <- struct{}{}
+		<-release
+		return result.Ok(1)
+	}, AdaptiveOptions{InitialLimit: 2})
+
+	done := make(chan result.Result[int], 2)
+	for i := 0; i < 2; i++ {
+		go func() { done <- call(context.Background()) }()
+		<-started
+	}
+
+	_, err := call(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrOverload)
+
+	close(release)
+	assert.Equal(t, result.Ok(1), <-done)
+	assert.Equal(t, result.Ok(1), <-done)
+}
+
+func TestAdaptiveLimiter_IncreaseAndDecrease(t *testing.T) {
+	l := newAdaptiveLimiter(AdaptiveOptions{InitialLimit: 4, MinLimit: 2, MaxLimit: 5})
+	assert.Equal(t, 4, l.current())
+
+	for i := 0; i < 5; i++ {
+		assert.True(t, l.acquire())
+		l.release(false, 0)
+	}
+	assert.Equal(t, 5, l.current())
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, l.acquire())
+		l.release(false, 0)
+	}
+	assert.Equal(t, 5, l.current())
+
+	for i := 0; i < 20; i++ {
+		assert.True(t, l.acquire())
+		l.release(true, 0)
+	}
+	assert.Equal(t, 2, l.current())
+}
+
+func TestAdaptiveLimiter_LatencyThreshold(t *testing.T) {
+	l := newAdaptiveLimiter(AdaptiveOptions{InitialLimit: 10, LatencyThreshold: 100 * time.Millisecond, Backoff: 0.5})
+	assert.True(t, l.acquire())
+	l.release(false, 50*time.Millisecond)
+	assert.Equal(t, 10, l.current())
+
+	assert.True(t, l.acquire())
+	l.release(false, 200*time.Millisecond)
+	assert.Equal(t, 5, l.current())
+}
+
+func TestAdaptive_Failures(t *testing.T) {
+	testErr := errors.New("boom")
+	var err error
+	call := Adaptive(func(ctx context.Context) result.Result[int] {
+		return result.Error[int](err)
+	}, AdaptiveOptions{InitialLimit: 1})
+
+	// Cancellation isn't treated as congestion so the limit stays at 1.
+	err = context.Canceled
+	for i := 0; i < 3; i++ {
+		_, got := call(context.Background()).Get()
+		assert.ErrorIs(t, got, context.Canceled)
+	}
+
+	err = testErr
+	_, got := call(context.Background()).Get()
+	assert.ErrorIs(t, got, testErr)
+}
+
+func TestAdaptive_Panics(t *testing.T) {
+	panics := true
+	call := Adaptive(func(ctx context.Context) result.Result[int] {
+		if panics {
+			panic("boom")
+		}
+		return result.Ok(1)
+	}, AdaptiveOptions{InitialLimit: 2, MinLimit: 1})
+
+	for i := 0; i < 5; i++ {
+		assert.PanicsWithValue(t, "boom", func() { call(context.Background()) })
+	}
+
+	panics = false
+	assert.Equal(t, result.Ok(1), call(context.Background()))
+}
+
+func TestNewAdaptiveLimiter_Defaults(t *testing.T) {
+	l := newAdaptiveLimiter(AdaptiveOptions{})
+	assert.Equal(t, defaultInitialLimit, l.current())
+	assert.Equal(t, float64(defaultMinLimit), l.min)
+	assert.Equal(t, float64(defaultMaxLimit), l.max)
+	assert.Equal(t, defaultBackoff, l.backoff)
+}
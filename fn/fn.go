@@ -0,0 +1,14 @@
+package fn
+
+import (
+	"context"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// Func is a fallible operation that accepts a context. The decorators in this
+// package accept and return Func so they can be stacked to build a call
+// protection chain from uniform pieces.
+//
+//	call := fn.RateLimited(limiter, fetchUser)
+type Func[T any] func(ctx context.Context) result.Result[T]
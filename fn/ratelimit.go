@@ -0,0 +1,61 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrRateLimited is returned when a call is rejected by a rate limiter.
+var ErrRateLimited = errors.New("rate limited")
+
+// Limiter controls how frequently calls are permitted. It is satisfied by
+// *rate.Limiter from golang.org/x/time/rate.
+type Limiter interface {
+	// Allow reports whether a call may happen now.
+	Allow() bool
+	// Wait blocks until a call is permitted or the context is done.
+	Wait(ctx context.Context) error
+}
+
+type rateLimitConfig struct {
+	failFast bool
+}
+
+// RateLimitOption configures the behavior of RateLimited.
+type RateLimitOption func(cfg *rateLimitConfig)
+
+// FailFast configures RateLimited to immediately return ErrRateLimited when the
+// limiter doesn't permit a call, rather than waiting.
+func FailFast() RateLimitOption {
+	return func(cfg *rateLimitConfig) {
+		cfg.failFast = true
+	}
+}
+
+// RateLimited decorates fn so that every invocation is permitted by the limiter
+// first. By default RateLimited waits until the limiter permits the call, if
+// waiting fails (ie the context is cancelled) an Error Result wrapping both
+// ErrRateLimited and the cause is returned without invoking fn. When configured
+// with FailFast calls that aren't permitted immediately return ErrRateLimited.
+func RateLimited[T any](limiter Limiter, fn Func[T], opts ...RateLimitOption) Func[T] {
+	cfg := rateLimitConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(ctx context.Context) result.Result[T] {
+		if cfg.failFast {
+			if !limiter.Allow() {
+				return result.Error[T](ErrRateLimited)
+			}
+			return fn(ctx)
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return result.Error[T](fmt.Errorf("%w: %w", ErrRateLimited, err))
+		}
+		return fn(ctx)
+	}
+}
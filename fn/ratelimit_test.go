@@ -0,0 +1,70 @@
+package fn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+type tokenLimiter struct {
+	tokens int
+	waits  int
+}
+
+func (l *tokenLimiter) Allow() bool {
+	if l.tokens <= 0 {
+		return false
+	}
+	l.tokens--
+	return true
+}
+
+func (l *tokenLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	if l.tokens <= 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	l.tokens--
+	return nil
+}
+
+func TestRateLimited(t *testing.T) {
+	calls := 0
+	call := func(ctx context.Context) result.Result[int] {
+		calls++
+		return result.Ok(calls)
+	}
+
+	limiter := &tokenLimiter{tokens: 1}
+	limited := RateLimited(limiter, call)
+	assert.Equal(t, 1, limited(context.Background()).Unwrap())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := limited(ctx).Get()
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, limiter.waits)
+}
+
+func TestRateLimited_FailFast(t *testing.T) {
+	calls := 0
+	call := func(ctx context.Context) result.Result[int] {
+		calls++
+		return result.Ok(calls)
+	}
+
+	limiter := &tokenLimiter{tokens: 1}
+	limited := RateLimited(limiter, call, FailFast())
+	assert.Equal(t, 1, limited(context.Background()).Unwrap())
+
+	_, err := limited(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 0, limiter.waits)
+}
@@ -0,0 +1,22 @@
+package fn
+
+import (
+	"context"
+	"time"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// now is the clock used to time calls, it is replaced in tests.
+var now = time.Now
+
+// Timed decorates fn so that every invocation also returns how long fn took. The
+// Result and duration can be combined using Result.WithDuration when they need
+// to travel together.
+func Timed[T any](fn Func[T]) func(ctx context.Context) (result.Result[T], time.Duration) {
+	return func(ctx context.Context) (result.Result[T], time.Duration) {
+		start := now()
+		res := fn(ctx)
+		return res, now().Sub(start)
+	}
+}
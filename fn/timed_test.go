@@ -0,0 +1,46 @@
+package fn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	clock := &fakeClock{t: time.Now()}
+	now = clock.now
+	t.Cleanup(func() {
+		now = time.Now
+	})
+	return clock
+}
+
+func TestTimed(t *testing.T) {
+	clock := useFakeClock(t)
+	testErr := errors.New("boom")
+
+	call := Timed(func(ctx context.Context) result.Result[int] {
+		clock.t = clock.t.Add(250 * time.Millisecond)
+		return result.Error[int](testErr)
+	})
+
+	res, elapsed := call(context.Background())
+	assert.ErrorIs(t, res.Error().Unwrap(), testErr)
+	assert.Equal(t, 250*time.Millisecond, elapsed)
+
+	timed := res.WithDuration(elapsed)
+	assert.Equal(t, 250*time.Millisecond, timed.Duration)
+}
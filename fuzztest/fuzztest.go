@@ -0,0 +1,133 @@
+// Package fuzztest provides helpers for writing native Go fuzz targets that check
+// Option and Result JSON encodings round-trip for a payload type.
+//
+//	func FuzzUser(f *testing.F) {
+//		fuzztest.SeedOption(f, User{Name: "Billy"})
+//		f.Fuzz(fuzztest.OptionJSON[User])
+//	}
+//
+// The checks use the process-wide Encoding of the option and result packages.
+package fuzztest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// SeedOption adds the JSON encoding of None and of Some containing each of the
+// values to the seed corpus of the fuzz test.
+func SeedOption[T any](f *testing.F, vals ...T) {
+	f.Helper()
+	seed(f, option.None[T]())
+	for _, val := range vals {
+		seed(f, option.Some(val))
+	}
+}
+
+// SeedResult adds the JSON encoding of an Error Result and of an Ok Result
+// containing each of the values to the seed corpus of the fuzz test.
+func SeedResult[T any](f *testing.F, vals ...T) {
+	f.Helper()
+	seed(f, result.Error[T](errors.New("error")))
+	for _, val := range vals {
+		seed(f, result.Ok(val))
+	}
+}
+
+func seed(f *testing.F, v any) {
+	f.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		f.Fatalf("marshal seed %v: %v", v, err)
+	}
+	f.Add(data)
+}
+
+// OptionJSON is a fuzz target checking that any JSON that decodes into an
+// Option[T] round-trips. The decoded Option is encoded and decoded again, the
+// second Option must be equal to the first according to gonads.DeepEqual and
+// encode to the same JSON. Input that doesn't decode is ignored.
+//
+// Since decoded errors never match the original error, use ResultJSON rather than
+// OptionJSON for payloads containing Results.
+func OptionJSON[T any](t *testing.T, data []byte) {
+	t.Helper()
+	var opt option.Option[T]
+	if err := json.Unmarshal(data, &opt); err != nil {
+		return
+	}
+	RoundTripOption(t, opt)
+}
+
+// ResultJSON is a fuzz target checking that any JSON that decodes into a
+// Result[T] round-trips. The decoded Result is encoded and decoded again, the
+// second Result must be equal to the first and encode to the same JSON. Since
+// only the error message is encoded, errors are compared by message. Input that
+// doesn't decode is ignored.
+func ResultJSON[T any](t *testing.T, data []byte) {
+	t.Helper()
+	var res result.Result[T]
+	if err := json.Unmarshal(data, &res); err != nil {
+		return
+	}
+	RoundTripResult(t, res)
+}
+
+// RoundTripOption checks that the Option is equal to itself after being encoded
+// to JSON and decoded again, and that re-encoding produces the same JSON.
+func RoundTripOption[T any](t testing.TB, opt option.Option[T]) {
+	t.Helper()
+	decoded, first, second := roundTrip(t, opt)
+	if !gonads.DeepEqual(opt, decoded) {
+		t.Fatalf("option changed after round trip: %s decoded as %v, expected %v", first, decoded, opt)
+	}
+	if !bytes.Equal(first, second) {
+		t.Fatalf("option encoding isn't stable: %s re-encoded as %s", first, second)
+	}
+}
+
+// RoundTripResult checks that the Result is equal to itself after being encoded
+// to JSON and decoded again, and that re-encoding produces the same JSON. Errors
+// are compared by message.
+func RoundTripResult[T any](t testing.TB, res result.Result[T]) {
+	t.Helper()
+	decoded, first, second := roundTrip(t, res)
+	val, err := res.Get()
+	decodedVal, decodedErr := decoded.Get()
+	switch {
+	case (err == nil) != (decodedErr == nil):
+		t.Fatalf("result changed after round trip: %s decoded as %v, expected %v", first, decoded, res)
+	case err != nil && err.Error() != decodedErr.Error():
+		t.Fatalf("result error changed after round trip: %q decoded as %q", err, decodedErr)
+	case err == nil && !gonads.DeepEqual(val, decodedVal):
+		t.Fatalf("result changed after round trip: %s decoded as %v, expected %v", first, decoded, res)
+	}
+	if !bytes.Equal(first, second) {
+		t.Fatalf("result encoding isn't stable: %s re-encoded as %s", first, second)
+	}
+}
+
+// roundTrip encodes v, decodes it into a new V, and encodes that again returning
+// the decoded value along with both encodings.
+func roundTrip[V any](t testing.TB, v V) (V, []byte, []byte) {
+	t.Helper()
+	var decoded V
+	first, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal %v: %v", v, err)
+	}
+	if err := json.Unmarshal(first, &decoded); err != nil {
+		t.Fatalf("unmarshal %s: %v", first, err)
+	}
+	second, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("marshal %v: %v", decoded, err)
+	}
+	return decoded, first, second
+}
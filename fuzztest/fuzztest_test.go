@@ -0,0 +1,49 @@
+package fuzztest
+
+import (
+	"testing"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+type user struct {
+	Name     string                `json:"name"`
+	Nickname option.Option[string] `json:"nickname,omitzero"`
+	Tags     []string              `json:"tags"`
+}
+
+func FuzzOptionJSON(f *testing.F) {
+	SeedOption(f, user{Name: "Billy"}, user{Name: "Bob", Nickname: option.Some("B"), Tags: []string{"a"}})
+	f.Add([]byte(`{"name":"Billy","nickname":null}`))
+	f.Add([]byte(`not json`))
+	f.Fuzz(OptionJSON[user])
+}
+
+func FuzzResultJSON(f *testing.F) {
+	SeedResult(f, user{Name: "Billy"})
+	f.Add([]byte(`{"ok":false,"error":""}`))
+	f.Fuzz(ResultJSON[user])
+}
+
+func TestRoundTripOption_Detects(t *testing.T) {
+	// Some(None) is encoded as null which decodes as None.
+	tb := &fatalTB{TB: t}
+	func() {
+		defer func() { _ = recover() }()
+		RoundTripOption(tb, option.Some(option.None[int]()))
+	}()
+	if !tb.failed {
+		t.Fatal("lossy round trip wasn't detected")
+	}
+}
+
+// fatalTB records calls to Fatalf and stops the check instead of failing the test.
+type fatalTB struct {
+	testing.TB
+	failed bool
+}
+
+func (f *fatalTB) Fatalf(string, ...any) {
+	f.failed = true
+	panic("fatal")
+}
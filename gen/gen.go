@@ -0,0 +1,118 @@
+// Package gen provides random value generators for Option and Result for use in
+// property-based tests, both for the standard library testing/quick package and
+// for pgregory.net/rapid.
+//
+//	check := func(opt option.Option[int]) bool {
+//		return opt.IsSome() != opt.IsNone()
+//	}
+//	cfg := &quick.Config{Values: gen.Values(gen.Option(gen.Arbitrary[int]()))}
+//	err := quick.Check(check, cfg)
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// size is the size hint passed to Generators by Values, it is the same as the
+// default used by testing/quick.
+const size = 50
+
+// Generator generates a random value of T for testing/quick using the provided
+// source of randomness. The size is a hint for how large generated values such
+// as slices and strings should be.
+type Generator[T any] func(r *rand.Rand, size int) T
+
+// Value generates a random value as a reflect.Value, allowing the Generator to be
+// used with Values.
+func (g Generator[T]) Value(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(g(r, size))
+}
+
+// Valuer is implemented by Generator of any type.
+type Valuer interface {
+	Value(r *rand.Rand, size int) reflect.Value
+}
+
+// Arbitrary returns a Generator of random values of T using quick.Value.
+// Arbitrary panics if testing/quick can't generate values of T, ie interfaces,
+// channels, and funcs.
+func Arbitrary[T any]() Generator[T] {
+	typ := reflect.TypeFor[T]()
+	return func(r *rand.Rand, size int) T {
+		val, ok := quick.Value(typ, r)
+		if !ok {
+			panic(fmt.Sprintf("cannot generate arbitrary values of %s", typ))
+		}
+		return val.Interface().(T)
+	}
+}
+
+// Option returns a Generator that produces None or Some with equal probability,
+// with the values of Some produced by the inner Generator.
+func Option[T any](inner Generator[T]) Generator[option.Option[T]] {
+	return func(r *rand.Rand, size int) option.Option[T] {
+		if r.Intn(2) == 0 {
+			return option.None[T]()
+		}
+		return option.Some(inner(r, size))
+	}
+}
+
+// Result returns a Generator that produces Ok or Error with equal probability,
+// with the values of Ok produced by the inner Generator and the errors of Error
+// by the errs Generator. If errs is nil Error is used.
+func Result[T any](inner Generator[T], errs Generator[error]) Generator[result.Result[T]] {
+	if errs == nil {
+		errs = Error()
+	}
+	return func(r *rand.Rand, size int) result.Result[T] {
+		if r.Intn(2) == 0 {
+			return result.Ok(inner(r, size))
+		}
+		return result.Error[T](errs(r, size))
+	}
+}
+
+// Error returns a Generator of non-nil errors with random messages.
+func Error() Generator[error] {
+	return func(r *rand.Rand, size int) error {
+		return fmt.Errorf("error %d", r.Intn(size+1))
+	}
+}
+
+// ErrorFrom returns a Generator that picks one of the provided errors at random,
+// useful when the code being tested branches on specific errors. ErrorFrom panics
+// if no errors are provided or any of them are nil.
+func ErrorFrom(errs ...error) Generator[error] {
+	if len(errs) == 0 {
+		panic("at least one error is required")
+	}
+	for _, err := range errs {
+		if err == nil {
+			panic("errors cannot be nil")
+		}
+	}
+	return func(r *rand.Rand, size int) error {
+		return errs[r.Intn(len(errs))]
+	}
+}
+
+// Values returns a func for quick.Config.Values that generates each argument of
+// the function being checked with the corresponding Generator. The number of
+// Generators must match the number of arguments.
+func Values(gens ...Valuer) func([]reflect.Value, *rand.Rand) {
+	return func(args []reflect.Value, r *rand.Rand) {
+		if len(args) != len(gens) {
+			panic("number of generators doesn't match the number of arguments")
+		}
+		for i, g := range gens {
+			args[i] = g.Value(r, size)
+		}
+	}
+}
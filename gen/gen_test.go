@@ -0,0 +1,79 @@
+package gen
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+func TestOption(t *testing.T) {
+	g := Option(Arbitrary[int]())
+	r := rand.New(rand.NewSource(1))
+
+	var some, none int
+	for i := 0; i < 100; i++ {
+		if g(r, size).IsSome() {
+			some++
+		} else {
+			none++
+		}
+	}
+	assert.Positive(t, some)
+	assert.Positive(t, none)
+}
+
+func TestResult(t *testing.T) {
+	errBoom := errors.New("boom")
+	g := Result(Arbitrary[string](), ErrorFrom(errBoom))
+	r := rand.New(rand.NewSource(1))
+
+	var ok, failed int
+	for i := 0; i < 100; i++ {
+		val, err := g(r, size).Get()
+		if err != nil {
+			assert.ErrorIs(t, err, errBoom)
+			assert.Empty(t, val)
+			failed++
+			continue
+		}
+		ok++
+	}
+	assert.Positive(t, ok)
+	assert.Positive(t, failed)
+
+	res := Result[int](Arbitrary[int](), nil)
+	for i := 0; i < 20; i++ {
+		res(r, size).IfError(func(err error) {
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestQuickCheck(t *testing.T) {
+	roundTrip := func(opt option.Option[int], res result.Result[string]) bool {
+		return result.OkOr(opt, errors.New("none")).IsOk() == opt.IsSome() &&
+			res.IsOk() != res.IsErr()
+	}
+	cfg := &quick.Config{Values: Values(
+		Option(Arbitrary[int]()),
+		Result(Arbitrary[string](), Error()),
+	)}
+	assert.NoError(t, quick.Check(roundTrip, cfg))
+}
+
+func TestPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		Arbitrary[error]()(rand.New(rand.NewSource(1)), size)
+	})
+	assert.Panics(t, func() { ErrorFrom() })
+	assert.Panics(t, func() { ErrorFrom(nil) })
+	assert.Panics(t, func() {
+		_ = quick.Check(func(int, int) bool { return true }, &quick.Config{Values: Values(Arbitrary[int]())})
+	})
+}
@@ -0,0 +1,43 @@
+package gen
+
+import (
+	"errors"
+
+	"pgregory.net/rapid"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// RapidOption returns a rapid Generator that produces None or Some, with the
+// values of Some drawn from the inner Generator.
+func RapidOption[T any](inner *rapid.Generator[T]) *rapid.Generator[option.Option[T]] {
+	return rapid.Custom(func(t *rapid.T) option.Option[T] {
+		if !rapid.Bool().Draw(t, "some") {
+			return option.None[T]()
+		}
+		return option.Some(inner.Draw(t, "value"))
+	})
+}
+
+// RapidResult returns a rapid Generator that produces Ok or Error, with the
+// values of Ok drawn from the inner Generator and the errors of Error drawn from
+// errs. If errs is nil RapidError is used.
+func RapidResult[T any](inner *rapid.Generator[T], errs *rapid.Generator[error]) *rapid.Generator[result.Result[T]] {
+	if errs == nil {
+		errs = RapidError()
+	}
+	return rapid.Custom(func(t *rapid.T) result.Result[T] {
+		if rapid.Bool().Draw(t, "ok") {
+			return result.Ok(inner.Draw(t, "value"))
+		}
+		return result.Error[T](errs.Draw(t, "err"))
+	})
+}
+
+// RapidError returns a rapid Generator of non-nil errors with random messages.
+func RapidError() *rapid.Generator[error] {
+	return rapid.Custom(func(t *rapid.T) error {
+		return errors.New(rapid.String().Draw(t, "message"))
+	})
+}
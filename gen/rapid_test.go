@@ -0,0 +1,37 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pgregory.net/rapid"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestRapidOption(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		opt := RapidOption(rapid.IntRange(0, 10)).Draw(t, "opt")
+		val := opt.UnwrapOrDefault(0)
+		if val < 0 || val > 10 {
+			t.Fatalf("value %d out of range", val)
+		}
+		if option.Equal(opt, option.None[int]()) != opt.IsNone() {
+			t.Fatalf("inconsistent option %v", opt)
+		}
+	})
+}
+
+func TestRapidResult(t *testing.T) {
+	var ok, failed int
+	rapid.Check(t, func(rt *rapid.T) {
+		res := RapidResult(rapid.String(), nil).Draw(rt, "res")
+		if _, err := res.Get(); err != nil {
+			failed++
+			return
+		}
+		ok++
+	})
+	assert.Positive(t, ok)
+	assert.Positive(t, failed)
+}
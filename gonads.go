@@ -13,3 +13,38 @@ type Supplier[T any] func() T
 
 // Function represents a function that accepts one argument and produces a result.
 type Function[T, R any] func(val T) R
+
+// Comparator represents a function that compares two values, returning a
+// negative number when a < b, zero when a == b, and a positive number when a > b.
+type Comparator[T any] func(a, b T) int
+
+// Pair represents a key and its associated value.
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Unit is a type with only one value, used as the value of a Result for
+// operations that succeed or fail but don't produce a meaningful value.
+type Unit struct{}
+
+// Tuple represents an ordered pair of values of possibly different types.
+type Tuple[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Tuple3 represents an ordered triple of values of possibly different types.
+type Tuple3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple4 represents an ordered quadruple of values of possibly different types.
+type Tuple4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
@@ -0,0 +1,51 @@
+package httpopt
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrMissingPathValue is returned when a required path value is absent or empty.
+var ErrMissingPathValue = errors.New("missing path value")
+
+// PathValue extracts the named path wildcard from the request, as matched by an
+// http.ServeMux pattern, and parses it using the provided parse function. If the
+// value is missing an Error Result wrapping ErrMissingPathValue is returned. If
+// parse returns an error an Error Result wrapping it is returned.
+func PathValue[T any](r *http.Request, name string, parse func(string) (T, error)) result.Result[T] {
+	raw := r.PathValue(name)
+	if raw == "" {
+		return result.Error[T](fmt.Errorf("path value %q: %w", name, ErrMissingPathValue))
+	}
+	val, err := parse(raw)
+	if err != nil {
+		return result.Error[T](fmt.Errorf("path value %q: %w", name, err))
+	}
+	return result.Ok(val)
+}
+
+// OptionalPathValue is similar to PathValue but is intended for optional path
+// segments. If the value is missing Ok(None) is returned instead of an error. If
+// the value is present but parse fails an Error Result is returned, since the
+// value was provided but is invalid.
+func OptionalPathValue[T any](r *http.Request, name string, parse func(string) (T, error)) result.Result[option.Option[T]] {
+	raw := r.PathValue(name)
+	if raw == "" {
+		return result.Ok(option.None[T]())
+	}
+	val, err := parse(raw)
+	if err != nil {
+		return result.Error[option.Option[T]](fmt.Errorf("path value %q: %w", name, err))
+	}
+	return result.Ok(option.Some(val))
+}
+
+// String is a parse function for PathValue and OptionalPathValue that returns the
+// raw path value as is.
+func String(s string) (string, error) {
+	return s, nil
+}
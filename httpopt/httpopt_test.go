@@ -0,0 +1,56 @@
+package httpopt
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func serve(pattern, target string, handler func(r *http.Request)) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		handler(r)
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+}
+
+func TestPathValue(t *testing.T) {
+	called := false
+	serve("/users/{id}", "/users/42", func(r *http.Request) {
+		called = true
+		assert.Equal(t, 42, PathValue(r, "id", strconv.Atoi).Unwrap())
+		assert.Equal(t, "42", PathValue(r, "id", String).Unwrap())
+
+		_, err := PathValue(r, "missing", strconv.Atoi).Get()
+		assert.ErrorIs(t, err, ErrMissingPathValue)
+	})
+	assert.True(t, called)
+
+	serve("/users/{id}", "/users/billy", func(r *http.Request) {
+		_, err := PathValue(r, "id", strconv.Atoi).Get()
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.Contains(t, err.Error(), `"id"`)
+	})
+}
+
+func TestOptionalPathValue(t *testing.T) {
+	called := false
+	serve("/files/{path...}", "/files/", func(r *http.Request) {
+		called = true
+		assert.Equal(t, option.None[string](), OptionalPathValue(r, "path", String).Unwrap())
+	})
+	assert.True(t, called)
+
+	serve("/files/{path...}", "/files/a/b.txt", func(r *http.Request) {
+		assert.Equal(t, option.Some("a/b.txt"), OptionalPathValue(r, "path", String).Unwrap())
+	})
+
+	serve("/page/{n...}", "/page/abc", func(r *http.Request) {
+		assert.True(t, OptionalPathValue(r, "n", strconv.Atoi).IsErr())
+	})
+}
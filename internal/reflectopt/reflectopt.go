@@ -0,0 +1,84 @@
+// Package reflectopt inspects Options and Results by reflection for code that
+// handles them generically, including the root gonads package which the option
+// and result packages depend on and so can't refer to their types.
+//
+// A type is treated as an Option if it has IsSome and a Get method returning
+// (T, bool), and as a Result if it has IsOk and a Get method returning (T, error).
+// Types that only look similar, such as option.Secret which deliberately has no
+// Get, aren't matched and should be handled like any other value.
+package reflectopt
+
+import (
+	"reflect"
+)
+
+var (
+	boolType  = reflect.TypeFor[bool]()
+	errorType = reflect.TypeFor[error]()
+)
+
+// IsOption reports whether typ is an Option type.
+func IsOption(typ reflect.Type) bool {
+	_, ok := getMethod(typ, "IsSome", boolType)
+	return ok
+}
+
+// IsResult reports whether typ is a Result type.
+func IsResult(typ reflect.Type) bool {
+	_, ok := getMethod(typ, "IsOk", errorType)
+	return ok
+}
+
+// OptionElem returns the type of the value contained by the Option type typ.
+func OptionElem(typ reflect.Type) (reflect.Type, bool) {
+	get, ok := getMethod(typ, "IsSome", boolType)
+	if !ok {
+		return nil, false
+	}
+	return get.Type.Out(0), true
+}
+
+// Option returns the value of the Option v and whether it is Some. isOption is
+// false if v isn't an Option.
+func Option(v reflect.Value) (val reflect.Value, ok bool, isOption bool) {
+	if !v.IsValid() || !IsOption(v.Type()) {
+		return reflect.Value{}, false, false
+	}
+	out := v.MethodByName("Get").Call(nil)
+	return out[0], out[1].Bool(), true
+}
+
+// Result returns the value and error of the Result v. isResult is false if v
+// isn't a Result.
+func Result(v reflect.Value) (val reflect.Value, err error, isResult bool) {
+	if !v.IsValid() || !IsResult(v.Type()) {
+		return reflect.Value{}, nil, false
+	}
+	out := v.MethodByName("Get").Call(nil)
+	err, _ = out[1].Interface().(error)
+	return out[0], err, true
+}
+
+// getMethod returns the Get method of typ if typ has a method named marker and a
+// Get method taking no arguments and returning a value and second.
+func getMethod(typ reflect.Type, marker string, second reflect.Type) (reflect.Method, bool) {
+	if typ == nil {
+		return reflect.Method{}, false
+	}
+	if _, ok := typ.MethodByName(marker); !ok {
+		return reflect.Method{}, false
+	}
+	get, ok := typ.MethodByName("Get")
+	if !ok {
+		return reflect.Method{}, false
+	}
+	// Methods of a concrete type include the receiver, interface methods don't.
+	in := 1
+	if typ.Kind() == reflect.Interface {
+		in = 0
+	}
+	if get.Type.NumIn() != in || get.Type.NumOut() != 2 || get.Type.Out(1) != second {
+		return reflect.Method{}, false
+	}
+	return get, true
+}
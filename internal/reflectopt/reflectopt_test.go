@@ -0,0 +1,49 @@
+package reflectopt_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+func TestOption(t *testing.T) {
+	val, ok, isOption := reflectopt.Option(reflect.ValueOf(option.Some(42)))
+	assert.True(t, isOption)
+	assert.True(t, ok)
+	assert.Equal(t, 42, val.Interface())
+
+	_, ok, isOption = reflectopt.Option(reflect.ValueOf(option.None[int]()))
+	assert.True(t, isOption)
+	assert.False(t, ok)
+
+	_, _, isOption = reflectopt.Option(reflect.ValueOf(option.NewSecret("hunter2")))
+	assert.False(t, isOption)
+	_, _, isOption = reflectopt.Option(reflect.ValueOf(result.Ok(1)))
+	assert.False(t, isOption)
+	_, _, isOption = reflectopt.Option(reflect.Value{})
+	assert.False(t, isOption)
+
+	elem, ok := reflectopt.OptionElem(reflect.TypeFor[option.Option[string]]())
+	assert.True(t, ok)
+	assert.Equal(t, reflect.TypeFor[string](), elem)
+}
+
+func TestResult(t *testing.T) {
+	val, err, isResult := reflectopt.Result(reflect.ValueOf(result.Ok("Billy")))
+	assert.True(t, isResult)
+	assert.NoError(t, err)
+	assert.Equal(t, "Billy", val.Interface())
+
+	_, err, isResult = reflectopt.Result(reflect.ValueOf(result.Error[string](errors.New("boom"))))
+	assert.True(t, isResult)
+	assert.EqualError(t, err, "boom")
+
+	assert.False(t, reflectopt.IsResult(reflect.TypeFor[option.Option[int]]()))
+	assert.False(t, reflectopt.IsResult(reflect.TypeFor[int]()))
+}
@@ -0,0 +1,99 @@
+// Package textvalue parses and formats the text representation of values for the
+// packages that decode Options and structs from text, such as flags, form values,
+// CSV records, and struct tag defaults, so they all accept the same input.
+package textvalue
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrUnsupported is returned for values whose type has no text representation.
+var ErrUnsupported = errors.New("type has no text representation")
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// Parse parses s into the settable value rv. If rv is addressable and its pointer
+// implements encoding.TextUnmarshaler it is used, time.Duration is parsed using
+// time.ParseDuration, and strings, booleans, and numeric types are parsed using
+// strconv. Any other type returns ErrUnsupported.
+func Parse(rv reflect.Value, s string) error {
+	if rv.CanAddr() {
+		if u, ok := rv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(s))
+		}
+	}
+	if rv.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// Format formats rv as text, the inverse of Parse. If rv implements
+// encoding.TextMarshaler it is used, time.Duration is formatted using its String
+// method, and strings, booleans, and numeric types are formatted using strconv.
+// Any other type returns ErrUnsupported.
+func Format(rv reflect.Value) ([]byte, error) {
+	if !rv.IsValid() {
+		return nil, ErrUnsupported
+	}
+	if m, ok := rv.Interface().(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	if rv.Type() == durationType {
+		return []byte(time.Duration(rv.Int()).String()), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,67 @@
+package textvalue
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parse[T any](t *testing.T, s string) (T, error) {
+	t.Helper()
+	var v T
+	err := Parse(reflect.ValueOf(&v).Elem(), s)
+	return v, err
+}
+
+func TestParse(t *testing.T) {
+	s, err := parse[string](t, "Billy")
+	assert.NoError(t, err)
+	assert.Equal(t, "Billy", s)
+
+	i, err := parse[int8](t, "-12")
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-12), i)
+
+	_, err = parse[int8](t, "300")
+	assert.Error(t, err)
+
+	d, err := parse[time.Duration](t, "1m30s")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, d)
+
+	_, err = parse[time.Duration](t, "30")
+	assert.Error(t, err)
+
+	addr, err := parse[netip.Addr](t, "127.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParseAddr("127.0.0.1"), addr)
+
+	_, err = parse[[]int](t, "1")
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		val      any
+		expected string
+	}{
+		{val: "Billy", expected: "Billy"},
+		{val: true, expected: "true"},
+		{val: -12, expected: "-12"},
+		{val: uint(7), expected: "7"},
+		{val: 1.5, expected: "1.5"},
+		{val: 90 * time.Second, expected: "1m30s"},
+		{val: netip.MustParseAddr("127.0.0.1"), expected: "127.0.0.1"},
+	}
+	for _, test := range tests {
+		text, err := Format(reflect.ValueOf(test.val))
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, string(text))
+	}
+
+	_, err := Format(reflect.ValueOf([]int{1}))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
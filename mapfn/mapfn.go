@@ -0,0 +1,87 @@
+package mapfn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrKeyConflict is returned by Merge when a key exists in both maps and no
+// conflict resolution function was provided.
+var ErrKeyConflict = errors.New("key exists in both maps")
+
+// ConflictFunc resolves a key present in both maps being merged. It receives the
+// key, the value from dst, and the value from src, and returns the value to keep
+// or an error if the conflict can't be resolved.
+type ConflictFunc[K comparable, V any] func(k K, a, b V) (V, error)
+
+// Merge combines dst and src into a new map. Keys that only exist in one of the
+// maps are copied as is. When a key exists in both maps onConflict is invoked to
+// determine the resulting value. If onConflict returns an error, Merge returns
+// an Error Result wrapping that error along with the offending key. If onConflict
+// is nil any conflicting key results in ErrKeyConflict.
+//
+// Neither dst nor src are modified, so a failed Merge never leaves a partially
+// merged map behind.
+func Merge[K comparable, V any](dst, src map[K]V, onConflict ConflictFunc[K, V]) result.Result[map[K]V] {
+	merged := make(map[K]V, len(dst)+len(src))
+	for k, v := range dst {
+		merged[k] = v
+	}
+	for k, b := range src {
+		a, exists := merged[k]
+		if !exists {
+			merged[k] = b
+			continue
+		}
+		if onConflict == nil {
+			return result.Error[map[K]V](fmt.Errorf("merge key %v: %w", k, ErrKeyConflict))
+		}
+		v, err := onConflict(k, a, b)
+		if err != nil {
+			return result.Error[map[K]V](fmt.Errorf("merge key %v: %w", k, err))
+		}
+		merged[k] = v
+	}
+	return result.Ok(merged)
+}
+
+// UnionKeys returns the keys present in either a or b. The order of the returned
+// keys is unspecified.
+func UnionKeys[K comparable, V any](a, b map[K]V) []K {
+	keys := make([]K, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, exists := a[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// IntersectKeys returns the keys present in both a and b. The order of the
+// returned keys is unspecified.
+func IntersectKeys[K comparable, V any](a, b map[K]V) []K {
+	keys := make([]K, 0)
+	for k := range a {
+		if _, exists := b[k]; exists {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// DifferenceKeys returns the keys present in a but not in b. The order of the
+// returned keys is unspecified.
+func DifferenceKeys[K comparable, V any](a, b map[K]V) []K {
+	keys := make([]K, 0)
+	for k := range a {
+		if _, exists := b[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
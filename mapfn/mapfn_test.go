@@ -0,0 +1,64 @@
+package mapfn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	dst := map[string]int{"a": 1, "b": 2}
+	src := map[string]int{"b": 3, "c": 4}
+
+	res := Merge(dst, src, func(k string, a, b int) (int, error) {
+		return a + b, nil
+	})
+	assert.True(t, res.IsOk())
+	assert.Equal(t, map[string]int{"a": 1, "b": 5, "c": 4}, res.Unwrap())
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, dst)
+	assert.Equal(t, map[string]int{"b": 3, "c": 4}, src)
+}
+
+func TestMerge_ConflictError(t *testing.T) {
+	testErr := errors.New("test error")
+	dst := map[string]int{"a": 1}
+	src := map[string]int{"a": 2}
+
+	res := Merge(dst, src, func(k string, a, b int) (int, error) {
+		return 0, testErr
+	})
+	assert.True(t, res.IsErr())
+	_, err := res.Get()
+	assert.ErrorIs(t, err, testErr)
+	assert.Contains(t, err.Error(), "a")
+}
+
+func TestMerge_NilConflictFunc(t *testing.T) {
+	res := Merge(map[string]int{"a": 1}, map[string]int{"b": 2}, nil)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, res.Unwrap())
+
+	res = Merge(map[string]int{"a": 1}, map[string]int{"a": 2}, nil)
+	_, err := res.Get()
+	assert.ErrorIs(t, err, ErrKeyConflict)
+}
+
+func TestUnionKeys(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 3, "c": 4}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, UnionKeys(a, b))
+}
+
+func TestIntersectKeys(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 3, "c": 4}
+	assert.ElementsMatch(t, []string{"b"}, IntersectKeys(a, b))
+	assert.Empty(t, IntersectKeys(a, map[string]int{}))
+}
+
+func TestDifferenceKeys(t *testing.T) {
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 3, "c": 4}
+	assert.ElementsMatch(t, []string{"a"}, DifferenceKeys(a, b))
+	assert.ElementsMatch(t, []string{"c"}, DifferenceKeys(b, a))
+}
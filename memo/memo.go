@@ -0,0 +1,176 @@
+package memo
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// now is the clock used for expiring cached entries, it is replaced in tests.
+var now = time.Now
+
+// ErrorPolicy determines how Error Results are treated by a memoized function.
+type ErrorPolicy int
+
+const (
+	// DontCacheErrors never caches Error Results, every call after a failure
+	// invokes the underlying function again. This is the default ErrorPolicy.
+	DontCacheErrors ErrorPolicy = iota
+	// CacheErrorsWithTTL caches all Error Results for Options.ErrorTTL. This
+	// protects an expensive or overloaded dependency from being hammered while
+	// still allowing recovery shortly after.
+	CacheErrorsWithTTL
+	// CacheSentinelErrors only caches Error Results where the error matches one
+	// of Options.Sentinels using errors.Is, for example a not found error that
+	// won't change on retry. Sentinel errors are cached for Options.ErrorTTL, or
+	// Options.TTL if ErrorTTL is zero.
+	CacheSentinelErrors
+)
+
+// Options configures the behavior of a memoized function.
+type Options struct {
+	// TTL is how long Ok Results are cached. A zero TTL caches Ok Results forever.
+	TTL time.Duration
+	// ErrorPolicy determines if and how Error Results are cached.
+	ErrorPolicy ErrorPolicy
+	// ErrorTTL is how long Error Results are cached when the ErrorPolicy permits
+	// caching errors.
+	ErrorTTL time.Duration
+	// Sentinels are the errors that are cached when using CacheSentinelErrors.
+	Sentinels []error
+	// MaxEntries is the maximum number of cached Results. When the cache is full
+	// expired entries are removed, and if none have expired a random entry is
+	// evicted. A zero MaxEntries doesn't limit the size of the cache, in which
+	// case expired entries are only removed when their key is requested again.
+	MaxEntries int
+}
+
+type entry[V any] struct {
+	res     result.Result[V]
+	expires time.Time
+}
+
+func (e entry[V]) expired(t time.Time) bool {
+	return !e.expires.IsZero() && !t.Before(e.expires)
+}
+
+// call is an in-flight invocation of the memoized function that concurrent
+// callers for the same key wait on.
+type call[V any] struct {
+	done chan struct{}
+	res  result.Result[V]
+	// completed is false if the function panicked.
+	completed bool
+}
+
+// Result memoizes a fallible function, caching Ok Results by key. Error Results
+// are handled according to the ErrorPolicy so transient failures aren't pinned
+// in the cache by accident.
+//
+// The returned function is safe for concurrent use. Concurrent calls for the same
+// key that miss the cache share a single invocation of fn and all receive its
+// Result, even if it isn't cached. If fn panics the panic propagates to the
+// caller that invoked it, and the waiting callers try again.
+func Result[K comparable, V any](fn func(K) result.Result[V], opts Options) func(K) result.Result[V] {
+	var mu sync.Mutex
+	cache := make(map[K]entry[V])
+	calls := make(map[K]*call[V])
+
+	invoke := func(key K, c *call[V]) result.Result[V] {
+		defer func() {
+			mu.Lock()
+			delete(calls, key)
+			mu.Unlock()
+			close(c.done)
+		}()
+
+		c.res = fn(key)
+		c.completed = true
+
+		_, err := c.res.Get()
+		ttl, cacheable := opts.ttlFor(err)
+		if !cacheable {
+			return c.res
+		}
+		e := entry[V]{res: c.res}
+		if ttl > 0 {
+			e.expires = now().Add(ttl)
+		}
+		mu.Lock()
+		if _, ok := cache[key]; !ok && opts.MaxEntries > 0 && len(cache) >= opts.MaxEntries {
+			evict(cache)
+		}
+		cache[key] = e
+		mu.Unlock()
+		return c.res
+	}
+
+	return func(key K) result.Result[V] {
+		for {
+			mu.Lock()
+			if e, ok := cache[key]; ok {
+				if !e.expired(now()) {
+					mu.Unlock()
+					return e.res
+				}
+				delete(cache, key)
+			}
+			if c, ok := calls[key]; ok {
+				mu.Unlock()
+				<-c.done
+				if c.completed {
+					return c.res
+				}
+				continue
+			}
+			c := &call[V]{done: make(chan struct{})}
+			calls[key] = c
+			mu.Unlock()
+			return invoke(key, c)
+		}
+	}
+}
+
+// evict makes room in a full cache by removing the expired entries, or a random
+// entry if none have expired.
+func evict[K comparable, V any](cache map[K]entry[V]) {
+	t := now()
+	removed := false
+	for key, e := range cache {
+		if e.expired(t) {
+			delete(cache, key)
+			removed = true
+		}
+	}
+	if removed {
+		return
+	}
+	for key := range cache {
+		delete(cache, key)
+		return
+	}
+}
+
+// ttlFor returns how long a Result with the given error should be cached and
+// whether it should be cached at all.
+func (o Options) ttlFor(err error) (time.Duration, bool) {
+	if err == nil {
+		return o.TTL, true
+	}
+	switch o.ErrorPolicy {
+	case CacheErrorsWithTTL:
+		return o.ErrorTTL, o.ErrorTTL > 0
+	case CacheSentinelErrors:
+		for _, sentinel := range o.Sentinels {
+			if errors.Is(err, sentinel) {
+				if o.ErrorTTL > 0 {
+					return o.ErrorTTL, true
+				}
+				return o.TTL, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,181 @@
+package memo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+var (
+	errNotFound  = errors.New("not found")
+	errTransient = errors.New("transient")
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	clock := &fakeClock{t: time.Now()}
+	now = clock.now
+	t.Cleanup(func() {
+		now = time.Now
+	})
+	return clock
+}
+
+func countingFn(calls map[string]int, errs map[string]error) func(string) result.Result[string] {
+	return func(key string) result.Result[string] {
+		calls[key]++
+		if err, ok := errs[key]; ok {
+			return result.Error[string](err)
+		}
+		return result.Ok("value-" + key)
+	}
+}
+
+func TestResult_CachesOk(t *testing.T) {
+	clock := useFakeClock(t)
+	calls := make(map[string]int)
+	fn := Result(countingFn(calls, nil), Options{TTL: time.Minute})
+
+	assert.Equal(t, "value-a", fn("a").Unwrap())
+	assert.Equal(t, "value-a", fn("a").Unwrap())
+	assert.Equal(t, 1, calls["a"])
+
+	clock.t = clock.t.Add(time.Minute)
+	assert.Equal(t, "value-a", fn("a").Unwrap())
+	assert.Equal(t, 2, calls["a"])
+}
+
+func TestResult_DontCacheErrors(t *testing.T) {
+	calls := make(map[string]int)
+	fn := Result(countingFn(calls, map[string]error{"a": errTransient}), Options{})
+
+	assert.True(t, fn("a").IsErr())
+	assert.True(t, fn("a").IsErr())
+	assert.Equal(t, 2, calls["a"])
+}
+
+func TestResult_CacheErrorsWithTTL(t *testing.T) {
+	clock := useFakeClock(t)
+	calls := make(map[string]int)
+	fn := Result(countingFn(calls, map[string]error{"a": errTransient}), Options{
+		ErrorPolicy: CacheErrorsWithTTL,
+		ErrorTTL:    time.Second,
+	})
+
+	assert.True(t, fn("a").IsErr())
+	assert.True(t, fn("a").IsErr())
+	assert.Equal(t, 1, calls["a"])
+
+	clock.t = clock.t.Add(time.Second)
+	assert.True(t, fn("a").IsErr())
+	assert.Equal(t, 2, calls["a"])
+}
+
+func TestResult_CacheSentinelErrors(t *testing.T) {
+	calls := make(map[string]int)
+	fn := Result(countingFn(calls, map[string]error{
+		"missing": errNotFound,
+		"flaky":   errTransient,
+	}), Options{
+		ErrorPolicy: CacheSentinelErrors,
+		Sentinels:   []error{errNotFound},
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := fn("missing").Get()
+		assert.ErrorIs(t, err, errNotFound)
+		_, err = fn("flaky").Get()
+		assert.ErrorIs(t, err, errTransient)
+	}
+	assert.Equal(t, 1, calls["missing"])
+	assert.Equal(t, 3, calls["flaky"])
+}
+
+func TestResult_MaxEntries(t *testing.T) {
+	clock := useFakeClock(t)
+	calls := make(map[string]int)
+	fn := Result(countingFn(calls, nil), Options{TTL: time.Minute, MaxEntries: 2})
+
+	fn("a")
+	clock.t = clock.t.Add(30 * time.Second)
+	fn("b")
+	clock.t = clock.t.Add(30 * time.Second)
+
+	// a has expired and is removed to make room for c
+	fn("c")
+	fn("b")
+	fn("c")
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, calls)
+
+	// nothing has expired so one of b or c is evicted to make room for d
+	fn("d")
+	fn("b")
+	fn("c")
+	assert.Equal(t, 3, calls["b"]+calls["c"])
+}
+
+func TestResult_SharesConcurrentMisses(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := Result(func(key string) result.Result[string] {
+		calls.Add(1)
+		<-release
+		return result.Error[string](errTransient)
+	}, Options{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(t, fn("a").Error().Unwrap(), errTransient)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestResult_Panic(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fn := Result(func(key string) result.Result[string] {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+			panic("boom")
+		}
+		return result.Ok("value-" + key)
+	}, Options{})
+
+	panicked := make(chan any, 1)
+	go func() {
+		defer func() { panicked <- recover() }()
+		fn("a")
+	}()
+	<-started
+
+	waiter := make(chan result.Result[string], 1)
+	go func() { waiter <- fn("a") }()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	assert.Equal(t, "boom", <-panicked)
+	assert.Equal(t, result.Ok("value-a"), <-waiter)
+	assert.Equal(t, int32(2), calls.Load())
+}
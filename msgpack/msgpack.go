@@ -0,0 +1,56 @@
+// Package msgpack integrates Option with github.com/vmihailenco/msgpack/v5.
+//
+// Since Go generics can't be registered with msgpack generically, each Option
+// instantiation used with msgpack needs to be registered once, typically during
+// program initialization.
+//
+//	func init() {
+//		msgpack.RegisterOption[string]()
+//		msgpack.RegisterOption[time.Time]()
+//	}
+package msgpack
+
+import (
+	"reflect"
+
+	vmsgpack "github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+// RegisterOption registers option.Option[T] with msgpack so that None is encoded
+// as nil and Some is encoded as the value itself. When decoding, nil results in
+// None and anything else is decoded into the value resulting in Some.
+func RegisterOption[T any]() {
+	vmsgpack.Register(option.None[T](), encodeOption[T], decodeOption[T])
+}
+
+func encodeOption[T any](enc *vmsgpack.Encoder, v reflect.Value) error {
+	val, ok := v.Interface().(option.Option[T]).Get()
+	if !ok {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(val)
+}
+
+func decodeOption[T any](dec *vmsgpack.Decoder, v reflect.Value) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	if code == msgpcode.Nil {
+		if err := dec.DecodeNil(); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(option.None[T]()))
+		return nil
+	}
+
+	var val T
+	if err := dec.Decode(&val); err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(option.Some(val)))
+	return nil
+}
@@ -0,0 +1,59 @@
+package msgpack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	vmsgpack "github.com/vmihailenco/msgpack/v5"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+type person struct {
+	Name     string                `msgpack:"name"`
+	Nickname option.Option[string] `msgpack:"nickname"`
+	Age      option.Option[int]    `msgpack:"age"`
+}
+
+func init() {
+	RegisterOption[string]()
+	RegisterOption[int]()
+}
+
+func TestRegisterOption(t *testing.T) {
+	p := person{
+		Name:     "Billy",
+		Nickname: option.None[string](),
+		Age:      option.Some(30),
+	}
+	data, err := vmsgpack.Marshal(p)
+	assert.NoError(t, err)
+
+	var raw map[string]any
+	assert.NoError(t, vmsgpack.Unmarshal(data, &raw))
+	assert.Nil(t, raw["nickname"])
+	assert.EqualValues(t, 30, raw["age"])
+
+	var decoded person
+	assert.NoError(t, vmsgpack.Unmarshal(data, &decoded))
+	assert.Equal(t, p, decoded)
+}
+
+func TestRegisterOption_Raw(t *testing.T) {
+	data, err := vmsgpack.Marshal(option.Some("Billy"))
+	assert.NoError(t, err)
+	expected, err := vmsgpack.Marshal("Billy")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	var opt option.Option[string]
+	assert.NoError(t, vmsgpack.Unmarshal(data, &opt))
+	assert.Equal(t, option.Some("Billy"), opt)
+
+	data, err = vmsgpack.Marshal(option.None[string]())
+	assert.NoError(t, err)
+	assert.NoError(t, vmsgpack.Unmarshal(data, &opt))
+	assert.True(t, opt.IsNone())
+
+	assert.Error(t, vmsgpack.Unmarshal([]byte{0xa1, 'x'}, new(option.Option[int])))
+}
@@ -0,0 +1,100 @@
+package nonempty
+
+import (
+	"errors"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrEmpty is returned by Parse when the provided slice contains no elements.
+var ErrEmpty = errors.New("cannot create NonEmpty from an empty slice, at least one element is required")
+
+// NonEmpty is a collection that is guaranteed to contain at least one element.
+//
+// The zero value isn't usable and a NonEmpty needs to be instantiated using one
+// of the factory functions: Of, FromSlice, or Parse.
+type NonEmpty[T any] struct {
+	items []T
+}
+
+// Of creates a NonEmpty from a head element and any number of additional
+// elements. Because the head is required, Of can never fail.
+func Of[T any](head T, tail ...T) NonEmpty[T] {
+	items := make([]T, 0, len(tail)+1)
+	items = append(items, head)
+	items = append(items, tail...)
+	return NonEmpty[T]{items: items}
+}
+
+// FromSlice creates a NonEmpty from a slice. If the slice is empty None is
+// returned, otherwise Some(NonEmpty).
+//
+// The slice is copied so later modifications to it are not reflected in the
+// NonEmpty.
+func FromSlice[T any](s []T) option.Option[NonEmpty[T]] {
+	if len(s) == 0 {
+		return option.None[NonEmpty[T]]()
+	}
+	return option.Some(NonEmpty[T]{items: copySlice(s)})
+}
+
+// Parse creates a NonEmpty from a slice. If the slice is empty a Result
+// containing ErrEmpty is returned.
+//
+// Parse is similar to FromSlice but is better suited when an empty slice should
+// be treated as a failure, such as validating input.
+func Parse[T any](s []T) result.Result[NonEmpty[T]] {
+	if len(s) == 0 {
+		return result.Error[NonEmpty[T]](ErrEmpty)
+	}
+	return result.Ok(NonEmpty[T]{items: copySlice(s)})
+}
+
+// Head returns the first element. Since a NonEmpty always contains at least one
+// element, Head never fails.
+func (n NonEmpty[T]) Head() T {
+	return n.items[0]
+}
+
+// Tail returns all elements after the first element. The returned slice may be
+// empty.
+func (n NonEmpty[T]) Tail() []T {
+	return copySlice(n.items[1:])
+}
+
+// Last returns the last element.
+func (n NonEmpty[T]) Last() T {
+	return n.items[len(n.items)-1]
+}
+
+// Len returns the number of elements, which is always at least one.
+func (n NonEmpty[T]) Len() int {
+	return len(n.items)
+}
+
+// Slice returns the elements as a slice. The returned slice is a copy and can
+// be safely modified.
+func (n NonEmpty[T]) Slice() []T {
+	return copySlice(n.items)
+}
+
+// Append returns a new NonEmpty with the provided values added to the end.
+func Append[T any](n NonEmpty[T], vals ...T) NonEmpty[T] {
+	items := make([]T, 0, len(n.items)+len(vals))
+	items = append(items, n.items...)
+	items = append(items, vals...)
+	return NonEmpty[T]{items: items}
+}
+
+// Concat returns a new NonEmpty containing the elements of a followed by the
+// elements of b.
+func Concat[T any](a, b NonEmpty[T]) NonEmpty[T] {
+	return Append(a, b.items...)
+}
+
+func copySlice[T any](s []T) []T {
+	c := make([]T, len(s))
+	copy(c, s)
+	return c
+}
@@ -0,0 +1,68 @@
+package nonempty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOf(t *testing.T) {
+	n := Of(1, 2, 3)
+	assert.Equal(t, []int{1, 2, 3}, n.items)
+	assert.Equal(t, 1, n.Head())
+	assert.Equal(t, 3, n.Last())
+	assert.Equal(t, 3, n.Len())
+
+	single := Of("Billy")
+	assert.Equal(t, "Billy", single.Head())
+	assert.Equal(t, "Billy", single.Last())
+	assert.Empty(t, single.Tail())
+}
+
+func TestFromSlice(t *testing.T) {
+	opt := FromSlice([]int{})
+	assert.True(t, opt.IsNone())
+
+	opt = FromSlice[int](nil)
+	assert.True(t, opt.IsNone())
+
+	s := []int{1, 2}
+	opt = FromSlice(s)
+	assert.True(t, opt.IsSome())
+	s[0] = 100
+	assert.Equal(t, []int{1, 2}, opt.Unwrap().Slice())
+}
+
+func TestParse(t *testing.T) {
+	res := Parse([]string{})
+	assert.True(t, res.IsErr())
+	_, err := res.Get()
+	assert.ErrorIs(t, err, ErrEmpty)
+
+	res = Parse([]string{"Billy", "Bob"})
+	assert.True(t, res.IsOk())
+	assert.Equal(t, []string{"Billy", "Bob"}, res.Unwrap().Slice())
+}
+
+func TestNonEmpty_Tail(t *testing.T) {
+	n := Of(1, 2, 3)
+	tail := n.Tail()
+	assert.Equal(t, []int{2, 3}, tail)
+	tail[0] = 100
+	assert.Equal(t, []int{1, 2, 3}, n.Slice())
+}
+
+func TestAppend(t *testing.T) {
+	n := Of(1)
+	appended := Append(n, 2, 3)
+	assert.Equal(t, []int{1, 2, 3}, appended.Slice())
+	assert.Equal(t, []int{1}, n.Slice())
+}
+
+func TestConcat(t *testing.T) {
+	a := Of(1, 2)
+	b := Of(3, 4)
+	assert.Equal(t, []int{1, 2, 3, 4}, Concat(a, b).Slice())
+	assert.Equal(t, []int{1, 2}, a.Slice())
+	assert.Equal(t, []int{3, 4}, b.Slice())
+}
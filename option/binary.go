@@ -0,0 +1,66 @@
+package option
+
+import (
+	"encoding"
+	"fmt"
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The encoding
+// is a single presence byte, 0 for None and 1 for Some, followed by the value
+// when Some. If the value implements encoding.BinaryMarshaler it is used,
+// otherwise the value is encoded the same as MarshalText.
+//
+// Unlike Encode, the binary encoding isn't versioned, it is intended for compact
+// storage in binary caches such as Redis or BoltDB.
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.exists {
+		return []byte{frameNone}, nil
+	}
+	var (
+		payload []byte
+		err     error
+	)
+	if m, ok := any(o.val).(encoding.BinaryMarshaler); ok {
+		payload, err = m.MarshalBinary()
+	} else {
+		payload, err = marshalText(o.val)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{frameSome}, payload...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface decoding
+// data produced by MarshalBinary. If *T implements encoding.BinaryUnmarshaler it
+// is used, otherwise the value is decoded the same as UnmarshalText.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: empty data", ErrInvalidFrame)
+	}
+	switch data[0] {
+	case frameNone:
+		if len(data) != 1 {
+			return fmt.Errorf("%w: unexpected payload for None", ErrInvalidFrame)
+		}
+		*o = None[T]()
+		return nil
+	case frameSome:
+		var v T
+		if u, ok := any(&v).(encoding.BinaryUnmarshaler); ok {
+			if err := u.UnmarshalBinary(data[1:]); err != nil {
+				return err
+			}
+			*o = Some(v)
+			return nil
+		}
+		v, err := unmarshalText[T](data[1:])
+		if err != nil {
+			return err
+		}
+		*o = Some(v)
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown presence byte %d", ErrInvalidFrame, data[0])
+	}
+}
@@ -0,0 +1,57 @@
+package option
+
+import (
+	"encoding"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption_MarshalBinary(t *testing.T) {
+	data, err := None[string]().MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, data)
+
+	data, err = Some("Billy").MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("\x01Billy"), data)
+
+	var b encoding.BinaryMarshaler = Some(42)
+	data, err = b.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("\x0142"), data)
+
+	_, err = Some(struct{}{}).MarshalBinary()
+	assert.Error(t, err)
+}
+
+func TestOption_BinaryRoundTrip(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	data, err := Some(now).MarshalBinary()
+	assert.NoError(t, err)
+
+	var decoded Option[time.Time]
+	assert.NoError(t, decoded.UnmarshalBinary(data))
+	assert.True(t, now.Equal(decoded.Unwrap()))
+
+	data, err = Some("").MarshalBinary()
+	assert.NoError(t, err)
+	var str Option[string]
+	assert.NoError(t, str.UnmarshalBinary(data))
+	assert.Equal(t, Some(""), str)
+
+	data, err = None[int]().MarshalBinary()
+	assert.NoError(t, err)
+	num := Some(1)
+	assert.NoError(t, num.UnmarshalBinary(data))
+	assert.Equal(t, None[int](), num)
+}
+
+func TestOption_UnmarshalBinaryInvalid(t *testing.T) {
+	var opt Option[int]
+	assert.ErrorIs(t, opt.UnmarshalBinary(nil), ErrInvalidFrame)
+	assert.ErrorIs(t, opt.UnmarshalBinary([]byte{0, 1}), ErrInvalidFrame)
+	assert.ErrorIs(t, opt.UnmarshalBinary([]byte{2}), ErrInvalidFrame)
+	assert.Error(t, opt.UnmarshalBinary([]byte("\x01abc")))
+}
@@ -0,0 +1,28 @@
+package option
+
+// bitset is a compact set of presence flags, one bit per index.
+type bitset []uint64
+
+// get returns the flag at index i. Indexes beyond the bitset are unset.
+func (b bitset) get(i int) bool {
+	word := i / 64
+	if word >= len(b) {
+		return false
+	}
+	return b[word]&(1<<(i%64)) != 0
+}
+
+// set sets the flag at index i, growing the bitset if needed.
+func (b *bitset) set(i int, v bool) {
+	word := i / 64
+	if !v {
+		if word < len(*b) {
+			(*b)[word] &^= 1 << (i % 64)
+		}
+		return
+	}
+	for word >= len(*b) {
+		*b = append(*b, 0)
+	}
+	(*b)[word] |= 1 << (i % 64)
+}
@@ -0,0 +1,136 @@
+package option
+
+import (
+	"encoding/json"
+
+	"github.com/jkratz55/gonads"
+)
+
+// Boxed is an Option that stores its value behind a pointer. Copying, filtering,
+// and mapping an Option[T] copies T every time which is costly for large structs,
+// while a Boxed is always the size of a pointer and passes the value to callbacks
+// by pointer.
+//
+// Copies of a Boxed share the same value, so the value must not be modified
+// through the pointers returned by Get or passed to callbacks unless that is
+// intended for every copy.
+//
+// The zero value is a Boxed that is None.
+type Boxed[T any] struct {
+	ptr *T
+}
+
+// Box creates a Boxed containing val. Like Some, Box panics if val is a nil
+// interface value.
+func Box[T any](val T) Boxed[T] {
+	opt := Some(val)
+	return Boxed[T]{ptr: &opt.val}
+}
+
+// BoxPtr creates a Boxed sharing the value ptr points to. If ptr is nil returns
+// None.
+func BoxPtr[T any](ptr *T) Boxed[T] {
+	return Boxed[T]{ptr: ptr}
+}
+
+// IsSome returns a boolean indicating if the Boxed contains a value.
+func (b Boxed[T]) IsSome() bool {
+	return b.ptr != nil
+}
+
+// IsNone returns a boolean indicating if the Boxed doesn't contain a value.
+func (b Boxed[T]) IsNone() bool {
+	return b.ptr == nil
+}
+
+// Get returns a pointer to the value along with a boolean indicating if the value
+// is present.
+func (b Boxed[T]) Get() (*T, bool) {
+	return b.ptr, b.ptr != nil
+}
+
+// IfSome invokes the provided func with a pointer to the value if the Boxed is
+// Some.
+func (b Boxed[T]) IfSome(fn func(val *T)) {
+	if b.ptr != nil {
+		fn(b.ptr)
+	}
+}
+
+// Filter returns the Boxed if it is Some and the predicate returns true for the
+// value, otherwise returns None.
+func (b Boxed[T]) Filter(fn func(val *T) bool) Boxed[T] {
+	if b.ptr == nil || !fn(b.ptr) {
+		return Boxed[T]{}
+	}
+	return b
+}
+
+// Unwrap returns a copy of the value, or panics if the Boxed is None.
+func (b Boxed[T]) Unwrap() T {
+	if b.ptr == nil {
+		panic("cannot unwrap none/nil value")
+	}
+	return *b.ptr
+}
+
+// UnwrapOrDefault returns a copy of the value, or if the Boxed is None returns the
+// default value provided.
+func (b Boxed[T]) UnwrapOrDefault(defaultVal T) T {
+	if b.ptr == nil {
+		return defaultVal
+	}
+	return *b.ptr
+}
+
+// UnwrapOrElse returns a copy of the value, or if the Boxed is None invokes the
+// Supplier.
+func (b Boxed[T]) UnwrapOrElse(fn gonads.Supplier[T]) T {
+	if b.ptr == nil {
+		return fn()
+	}
+	return *b.ptr
+}
+
+// Option converts the Boxed to an Option, copying the value.
+func (b Boxed[T]) Option() Option[T] {
+	if b.ptr == nil {
+		return None[T]()
+	}
+	return Option[T]{val: *b.ptr, exists: true}
+}
+
+// MarshalJSON marshals the Boxed to JSON the same as Option.
+func (b Boxed[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(b.Option(), jsonEncoding())
+}
+
+// UnmarshalJSON unmarshalls JSON to the Boxed the same as Option.
+func (b *Boxed[T]) UnmarshalJSON(data []byte) error {
+	var opt Option[T]
+	if err := json.Unmarshal(data, &opt); err != nil {
+		return err
+	}
+	*b = Boxed[T]{}
+	if opt.exists {
+		b.ptr = &opt.val
+	}
+	return nil
+}
+
+// BoxFrom converts an Option to a Boxed.
+func BoxFrom[T any](opt Option[T]) Boxed[T] {
+	if !opt.exists {
+		return Boxed[T]{}
+	}
+	return Boxed[T]{ptr: &opt.val}
+}
+
+// MapBoxed converts a Boxed[T] -> Boxed[R] by invoking the mapper function with a
+// pointer to the value. If the Boxed is None, then None is returned.
+func MapBoxed[T, R any](b Boxed[T], fn func(val *T) R) Boxed[R] {
+	if b.ptr == nil {
+		return Boxed[R]{}
+	}
+	return Box(fn(b.ptr))
+}
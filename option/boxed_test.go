@@ -0,0 +1,87 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type payload struct {
+	ID   int
+	Data [4096]byte
+}
+
+func TestBoxed(t *testing.T) {
+	b := Box(payload{ID: 1})
+	assert.True(t, b.IsSome())
+	assert.False(t, b.IsNone())
+
+	ptr, ok := b.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 1, ptr.ID)
+
+	called := false
+	b.IfSome(func(val *payload) { called = val.ID == 1 })
+	assert.True(t, called)
+
+	assert.True(t, b.Filter(func(val *payload) bool { return val.ID == 1 }).IsSome())
+	assert.True(t, b.Filter(func(val *payload) bool { return val.ID == 2 }).IsNone())
+
+	id := MapBoxed(b, func(val *payload) int { return val.ID })
+	assert.Equal(t, 1, id.Unwrap())
+	assert.Equal(t, Some(1), id.Option())
+}
+
+func TestBoxed_None(t *testing.T) {
+	var b Boxed[int]
+	assert.True(t, b.IsNone())
+	assert.Panics(t, func() { b.Unwrap() })
+	assert.Equal(t, 5, b.UnwrapOrDefault(5))
+	assert.Equal(t, 6, b.UnwrapOrElse(func() int { return 6 }))
+	assert.Equal(t, None[int](), b.Option())
+	assert.True(t, MapBoxed(b, func(val *int) int { return *val }).IsNone())
+	assert.True(t, BoxPtr[int](nil).IsNone())
+	assert.Panics(t, func() { Box[error](nil) })
+}
+
+func TestBoxed_Conversions(t *testing.T) {
+	assert.Equal(t, Some(42), BoxFrom(Some(42)).Option())
+	assert.True(t, BoxFrom(None[int]()).IsNone())
+
+	val := 42
+	b := BoxPtr(&val)
+	val = 43
+	assert.Equal(t, 43, b.Unwrap())
+}
+
+func TestBoxed_JSON(t *testing.T) {
+	data, err := json.Marshal([]Boxed[string]{Box("Billy"), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `["Billy",null]`, string(data))
+
+	var decoded []Boxed[string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "Billy", decoded[0].Unwrap())
+	assert.True(t, decoded[1].IsNone())
+}
+
+func BenchmarkOption_Pipeline(b *testing.B) {
+	opt := Some(payload{ID: 1})
+	for i := 0; i < b.N; i++ {
+		res := opt.
+			Filter(func(val payload) bool { return val.ID > 0 }).
+			Filter(func(val payload) bool { return val.Data[0] == 0 })
+		_ = Map(res, func(val payload) int { return val.ID })
+	}
+}
+
+func BenchmarkBoxed_Pipeline(b *testing.B) {
+	boxed := Box(payload{ID: 1})
+	for i := 0; i < b.N; i++ {
+		res := boxed.
+			Filter(func(val *payload) bool { return val.ID > 0 }).
+			Filter(func(val *payload) bool { return val.Data[0] == 0 })
+		_ = MapBoxed(res, func(val *payload) int { return val.ID })
+	}
+}
@@ -0,0 +1,81 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Builder constructs a large number of Options in a columnar layout, storing the
+// values in a single backing slice alongside a presence bitmap. Compared to a
+// []Option[T] a Builder avoids the padding of the presence flag for every element
+// and amortizes allocations, which is useful on hot decoding paths such as
+// scanning many rows from a database.
+//
+// The zero value isn't usable and a Builder needs to be created with NewBuilder.
+// A Builder isn't safe for concurrent use.
+type Builder[T any] struct {
+	values  []T
+	present bitset
+	iface   bool
+}
+
+// NewBuilder creates a Builder with space for capacity Options preallocated.
+func NewBuilder[T any](capacity int) *Builder[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &Builder[T]{
+		values:  make([]T, 0, capacity),
+		present: make(bitset, 0, (capacity+63)/64),
+		iface:   reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Interface,
+	}
+}
+
+// Append appends a Some Option containing val. Like Some, Append panics if val
+// is a nil interface value.
+func (b *Builder[T]) Append(val T) {
+	if b.iface && any(val) == nil {
+		panic("cannot provide a nil value for Some")
+	}
+	b.present.set(len(b.values), true)
+	b.values = append(b.values, val)
+}
+
+// AppendNone appends a None Option.
+func (b *Builder[T]) AppendNone() {
+	var zero T
+	b.values = append(b.values, zero)
+}
+
+// AppendOption appends the provided Option.
+func (b *Builder[T]) AppendOption(opt Option[T]) {
+	if opt.exists {
+		b.Append(opt.val)
+		return
+	}
+	b.AppendNone()
+}
+
+// Len returns the number of Options appended to the Builder.
+func (b *Builder[T]) Len() int {
+	return len(b.values)
+}
+
+// At returns the Option at index i. At panics if i is out of range.
+func (b *Builder[T]) At(i int) Option[T] {
+	if i < 0 || i >= len(b.values) {
+		panic(fmt.Sprintf("index %d out of range [0:%d]", i, len(b.values)))
+	}
+	if !b.present.get(i) {
+		return None[T]()
+	}
+	return Option[T]{val: b.values[i], exists: true}
+}
+
+// Reset removes all Options from the Builder while retaining the allocated memory
+// so the Builder can be reused for the next batch.
+func (b *Builder[T]) Reset() {
+	clear(b.values)
+	b.values = b.values[:0]
+	b.present = b.present[:0]
+}
@@ -0,0 +1,48 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder[int](10)
+	for i := 0; i < 200; i++ {
+		if i%3 == 0 {
+			b.AppendNone()
+			continue
+		}
+		b.Append(i)
+	}
+	b.AppendOption(Some(500))
+	b.AppendOption(None[int]())
+
+	assert.Equal(t, 202, b.Len())
+	for i := 0; i < 200; i++ {
+		if i%3 == 0 {
+			assert.Equal(t, None[int](), b.At(i))
+			continue
+		}
+		assert.Equal(t, Some(i), b.At(i))
+	}
+	assert.Equal(t, Some(500), b.At(200))
+	assert.Equal(t, None[int](), b.At(201))
+	assert.Panics(t, func() { b.At(202) })
+	assert.Panics(t, func() { b.At(-1) })
+}
+
+func TestBuilder_Reset(t *testing.T) {
+	b := NewBuilder[string](0)
+	b.Append("hello")
+	b.Reset()
+	assert.Equal(t, 0, b.Len())
+
+	b.AppendNone()
+	assert.Equal(t, None[string](), b.At(0))
+}
+
+func TestBuilder_NilInterface(t *testing.T) {
+	b := NewBuilder[error](1)
+	assert.Panics(t, func() { b.Append(nil) })
+}
@@ -0,0 +1,67 @@
+package option
+
+import (
+	"bytes"
+	"errors"
+	"sync/atomic"
+)
+
+var (
+	cborNull      = []byte{0xf6}
+	cborUndefined = []byte{0xf7}
+)
+
+// ErrNoCBORCodec is returned when encoding or decoding an Option as CBOR before a
+// codec has been registered with SetCBORCodec.
+var ErrNoCBORCodec = errors.New("no CBOR codec registered, import github.com/jkratz55/gonads/option/cborcompat")
+
+// CBORCodec encodes and decodes the value of an Option as CBOR.
+type CBORCodec struct {
+	Marshal   func(v any) ([]byte, error)
+	Unmarshal func(data []byte, v any) error
+}
+
+var currentCBORCodec atomic.Pointer[CBORCodec]
+
+// SetCBORCodec sets the process-wide CBORCodec used by MarshalCBOR and
+// UnmarshalCBOR. The option package doesn't depend on a CBOR library, importing
+// the cborcompat package registers github.com/fxamacker/cbor/v2.
+//
+// SetCBORCodec is intended to be called once during program initialization. It
+// is safe for concurrent use.
+func SetCBORCodec(codec CBORCodec) {
+	currentCBORCodec.Store(&codec)
+}
+
+// MarshalCBOR implements the cbor.Marshaler interface. None is encoded as CBOR
+// null and Some is encoded as the value itself using the registered CBORCodec.
+func (o Option[T]) MarshalCBOR() ([]byte, error) {
+	if !o.exists {
+		return cborNull, nil
+	}
+	codec := currentCBORCodec.Load()
+	if codec == nil {
+		return nil, ErrNoCBORCodec
+	}
+	return codec.Marshal(o.val)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface. CBOR null and undefined
+// are decoded as None, anything else is decoded into the value using the
+// registered CBORCodec resulting in Some.
+func (o *Option[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 0 || bytes.Equal(data, cborNull) || bytes.Equal(data, cborUndefined) {
+		*o = None[T]()
+		return nil
+	}
+	codec := currentCBORCodec.Load()
+	if codec == nil {
+		return ErrNoCBORCodec
+	}
+	var v T
+	if err := codec.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
@@ -0,0 +1,21 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption_CBORWithoutCodec(t *testing.T) {
+	data, err := None[int]().MarshalCBOR()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xf6}, data)
+
+	_, err = Some(1).MarshalCBOR()
+	assert.ErrorIs(t, err, ErrNoCBORCodec)
+
+	var opt Option[int]
+	assert.ErrorIs(t, opt.UnmarshalCBOR([]byte{0x01}), ErrNoCBORCodec)
+	assert.NoError(t, opt.UnmarshalCBOR([]byte{0xf6}))
+	assert.True(t, opt.IsNone())
+}
@@ -0,0 +1,30 @@
+// Package cborcompat registers github.com/fxamacker/cbor/v2 as the CBOR codec of
+// Option, so the option package itself doesn't depend on a CBOR library. Import
+// the package for its side effects to encode Options as CBOR:
+//
+//	import _ "github.com/jkratz55/gonads/option/cborcompat"
+//
+// None is encoded as CBOR null and Some as the value itself.
+package cborcompat
+
+import (
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func init() {
+	option.SetCBORCodec(option.CBORCodec{
+		Marshal:   cbor.Marshal,
+		Unmarshal: cbor.Unmarshal,
+	})
+}
+
+// Use registers the encoding and decoding modes used for the values of Options,
+// replacing the defaults of the cbor package.
+func Use(em cbor.EncMode, dm cbor.DecMode) {
+	option.SetCBORCodec(option.CBORCodec{
+		Marshal:   em.Marshal,
+		Unmarshal: dm.Unmarshal,
+	})
+}
@@ -0,0 +1,68 @@
+package cborcompat
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+type cborDevice struct {
+	ID    string                  `cbor:"id"`
+	Label option.Option[string]   `cbor:"label"`
+	Temp  option.Option[float64]  `cbor:"temp"`
+	Tags  option.Option[[]string] `cbor:"tags"`
+}
+
+func TestMarshalCBOR(t *testing.T) {
+	data, err := cbor.Marshal(option.None[string]())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xf6}, data)
+
+	data, err = cbor.Marshal(option.Some(uint(10)))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x0a}, data)
+
+	d := cborDevice{
+		ID:    "sensor-1",
+		Label: option.None[string](),
+		Temp:  option.Some(21.5),
+		Tags:  option.Some([]string{"a"}),
+	}
+	data, err = cbor.Marshal(d)
+	assert.NoError(t, err)
+
+	var decoded cborDevice
+	assert.NoError(t, cbor.Unmarshal(data, &decoded))
+	assert.Equal(t, d, decoded)
+}
+
+func TestUnmarshalCBOR(t *testing.T) {
+	var opt option.Option[int]
+	assert.NoError(t, cbor.Unmarshal([]byte{0xf6}, &opt))
+	assert.True(t, opt.IsNone())
+	assert.NoError(t, cbor.Unmarshal([]byte{0x0a}, &opt))
+	assert.Equal(t, option.Some(10), opt)
+	assert.NoError(t, cbor.Unmarshal([]byte{0xf7}, &opt))
+	assert.True(t, opt.IsNone())
+	assert.Error(t, cbor.Unmarshal([]byte{0x61, 'x'}, &opt))
+}
+
+func TestUse(t *testing.T) {
+	defer Use(must(cbor.EncOptions{}.EncMode()), must(cbor.DecOptions{}.DecMode()))
+
+	em := must(cbor.EncOptions{ShortestFloat: cbor.ShortestFloat16}.EncMode())
+	Use(em, must(cbor.DecOptions{}.DecMode()))
+	data, err := cbor.Marshal(option.Some(1.5))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xf9, 0x3e, 0x00}, data)
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
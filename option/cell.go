@@ -0,0 +1,69 @@
+package option
+
+import (
+	"sync"
+)
+
+// Cell is an Option that can be safely shared across goroutines, ie as a slot a
+// value is published to by one goroutine and consumed by others.
+//
+// Cell is safe for concurrent use and must not be copied after first use. The
+// zero value is a Cell containing None and is ready to use.
+type Cell[T any] struct {
+	mu  sync.Mutex
+	opt Option[T]
+}
+
+// NewCell creates a Cell containing the provided Option.
+func NewCell[T any](opt Option[T]) *Cell[T] {
+	return &Cell[T]{opt: opt}
+}
+
+// Load returns the Option contained in the Cell.
+func (c *Cell[T]) Load() Option[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opt
+}
+
+// Store replaces the Option contained in the Cell.
+func (c *Cell[T]) Store(opt Option[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.opt = opt
+}
+
+// Swap replaces the Option contained in the Cell and returns the previous Option.
+func (c *Cell[T]) Swap(opt Option[T]) Option[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	prev := c.opt
+	c.opt = opt
+	return prev
+}
+
+// TakeIfSome moves the value out of the Cell leaving None in its place. If the
+// Cell contains None it is left unchanged and None is returned. When multiple
+// goroutines race to take a value only one of them receives it.
+func (c *Cell[T]) TakeIfSome() Option[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.opt.Take()
+}
+
+// CompareAndSwap replaces the Option contained in the Cell with new if the Cell
+// currently contains an Option equal to old, returning a boolean indicating if
+// the swap happened. Two Options are equal if both are None, or both are Some
+// with equal values.
+//
+// CompareAndSwap is a function rather than a method since it requires T to be
+// comparable.
+func CompareAndSwap[T comparable](c *Cell[T], old, new Option[T]) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !Equal(c.opt, old) {
+		return false
+	}
+	c.opt = new
+	return true
+}
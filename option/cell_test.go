@@ -0,0 +1,50 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCell(t *testing.T) {
+	var c Cell[string]
+	assert.Equal(t, None[string](), c.Load())
+
+	c.Store(Some("Billy"))
+	assert.Equal(t, Some("Billy"), c.Load())
+
+	assert.Equal(t, Some("Billy"), c.Swap(Some("Bob")))
+	assert.Equal(t, Some("Bob"), c.TakeIfSome())
+	assert.Equal(t, None[string](), c.TakeIfSome())
+	assert.Equal(t, None[string](), c.Load())
+
+	assert.Equal(t, Some(1), NewCell(Some(1)).Load())
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	c := NewCell(None[int]())
+	assert.True(t, CompareAndSwap(c, None[int](), Some(1)))
+	assert.False(t, CompareAndSwap(c, None[int](), Some(2)))
+	assert.False(t, CompareAndSwap(c, Some(2), Some(3)))
+	assert.True(t, CompareAndSwap(c, Some(1), None[int]()))
+	assert.Equal(t, None[int](), c.Load())
+}
+
+func TestCell_TakeIfSomeConcurrent(t *testing.T) {
+	c := NewCell(Some(42))
+	var taken atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if c.TakeIfSome().IsSome() {
+				taken.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), taken.Load())
+}
@@ -0,0 +1,50 @@
+package option
+
+// Scope is the context of a Chain. Bind uses it to exit the Chain early when an
+// Option is None.
+type Scope struct {
+	_ byte
+}
+
+// exit is the panic value used by Bind to unwind to its Chain.
+type exit struct {
+	scope *Scope
+}
+
+// Chain runs a multi step Option pipeline written as straight-line code. Within
+// fn, Bind unwraps each Option along the way and if any of them is None the rest
+// of fn is skipped and Chain returns None. Otherwise, Chain returns Some
+// containing the value returned by fn.
+//
+// Since methods can't introduce type parameters, chaining Options of different
+// types otherwise requires nesting FlatMap calls. Chain keeps the steps linear:
+//
+//	city := option.Chain(func(s *option.Scope) string {
+//		user := option.Bind(s, findUser(id))
+//		addr := option.Bind(s, user.Address)
+//		return addr.City
+//	})
+//
+// The Scope must not be used outside fn or from another goroutine.
+func Chain[R any](fn func(s *Scope) R) (out Option[R]) {
+	s := &Scope{}
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(exit); ok && e.scope == s {
+				out = None[R]()
+				return
+			}
+			panic(r)
+		}
+	}()
+	return Some(fn(s))
+}
+
+// Bind returns the value of the Option if it is Some. If it is None, Bind exits
+// the Chain the Scope belongs to, which then returns None.
+func Bind[T any](s *Scope, opt Option[T]) T {
+	if !opt.exists {
+		panic(exit{scope: s})
+	}
+	return opt.val
+}
@@ -0,0 +1,72 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type location struct {
+	City string
+}
+
+type account struct {
+	Name    string
+	Address Option[location]
+}
+
+func TestChain(t *testing.T) {
+	accounts := map[int]account{
+		1: {Name: "Billy", Address: Some(location{City: "Pittsburgh"})},
+		2: {Name: "Bob"},
+	}
+	city := func(id int) Option[string] {
+		return Chain(func(s *Scope) string {
+			acct := Bind(s, FromMap(accounts, id))
+			addr := Bind(s, acct.Address)
+			return addr.City
+		})
+	}
+
+	assert.Equal(t, Some("Pittsburgh"), city(1))
+	assert.Equal(t, None[string](), city(2))
+	assert.Equal(t, None[string](), city(3))
+}
+
+func TestChain_SkipsRemainingSteps(t *testing.T) {
+	reached := false
+	res := Chain(func(s *Scope) int {
+		Bind(s, None[string]())
+		reached = true
+		return 1
+	})
+	assert.Equal(t, None[int](), res)
+	assert.False(t, reached)
+}
+
+func TestChain_Nested(t *testing.T) {
+	res := Chain(func(outer *Scope) int {
+		inner := Chain(func(s *Scope) int {
+			return Bind(s, None[int]())
+		})
+		return Bind(outer, inner.Or(Some(1))) + 1
+	})
+	assert.Equal(t, Some(2), res)
+
+	// Binding the outer scope in an inner Chain exits the outer Chain
+	res = Chain(func(outer *Scope) int {
+		Chain(func(s *Scope) int {
+			return Bind(outer, None[int]())
+		})
+		return 1
+	})
+	assert.Equal(t, None[int](), res)
+}
+
+func TestChain_PropagatesPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		Chain(func(s *Scope) int {
+			panic("boom")
+		})
+	})
+}
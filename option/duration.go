@@ -0,0 +1,143 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Duration is an optional time.Duration that is encoded in the format accepted by
+// time.ParseDuration, ie "30s", rather than as an integer number of nanoseconds.
+// Duration is intended for optional timeouts and intervals in configuration.
+//
+// In JSON and YAML None is encoded as null, and decoding also accepts an integer
+// number of nanoseconds for compatibility with time.Duration. In text None is
+// encoded as an empty string. The zero value is None.
+type Duration struct {
+	Option[time.Duration]
+}
+
+// SomeDuration creates a Duration containing d.
+func SomeDuration(d time.Duration) Duration {
+	return Duration{Option: Some(d)}
+}
+
+// ParseDuration parses a Duration using time.ParseDuration. An empty string is
+// parsed as None.
+func ParseDuration(s string) (Duration, error) {
+	if s == "" {
+		return Duration{}, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Duration{}, err
+	}
+	return SomeDuration(d), nil
+}
+
+// OrDefault returns the duration if the Duration is Some, otherwise returns def.
+func (d Duration) OrDefault(def time.Duration) time.Duration {
+	return d.UnwrapOrDefault(def)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.exists {
+		return []byte{}, nil
+	}
+	return []byte(d.val.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON marshals the Duration to a JSON string, or null if it is None.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.exists {
+		return jsonNull, nil
+	}
+	return json.Marshal(d.val.String())
+}
+
+// UnmarshalJSON unmarshalls a JSON string, an integer number of nanoseconds, or
+// null to the Duration.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var raw any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case nil:
+		*d = Duration{}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("invalid duration %s: must be an integer number of nanoseconds", data)
+		}
+		*d = SomeDuration(time.Duration(n))
+		return nil
+	default:
+		return fmt.Errorf("invalid duration %s", data)
+	}
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (d Duration) MarshalYAML() (any, error) {
+	if !d.exists {
+		return nil, nil
+	}
+	return d.val.String(), nil
+}
+
+// UnmarshalYAML implements the obsolete yaml.Unmarshaler interface, the same as
+// Option.UnmarshalYAML. Like UnmarshalJSON it accepts a string, an integer number
+// of nanoseconds, or null.
+func (d *Duration) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case nil:
+		*d = Duration{}
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int:
+		*d = SomeDuration(time.Duration(v))
+		return nil
+	case int64:
+		*d = SomeDuration(time.Duration(v))
+		return nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return fmt.Errorf("invalid duration %d: out of range", v)
+		}
+		*d = SomeDuration(time.Duration(v))
+		return nil
+	default:
+		return fmt.Errorf("invalid duration %v: must be a string or an integer number of nanoseconds", v)
+	}
+}
+
+// AddOpt adds two optional durations. If both are Some, returns Some containing
+// their sum, otherwise returns None.
+func AddOpt(a, b Option[time.Duration]) Option[time.Duration] {
+	return Map2(a, b, func(a, b time.Duration) time.Duration {
+		return a + b
+	})
+}
@@ -0,0 +1,100 @@
+package option
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type serverConfig struct {
+	Timeout  Duration `json:"timeout" yaml:"timeout"`
+	Interval Duration `json:"interval" yaml:"interval"`
+}
+
+func TestParseDuration(t *testing.T) {
+	d, err := ParseDuration("30s")
+	assert.NoError(t, err)
+	assert.Equal(t, SomeDuration(30*time.Second), d)
+
+	d, err = ParseDuration("")
+	assert.NoError(t, err)
+	assert.True(t, d.IsNone())
+
+	_, err = ParseDuration("thirty")
+	assert.Error(t, err)
+}
+
+func TestDuration_OrDefault(t *testing.T) {
+	assert.Equal(t, time.Second, SomeDuration(time.Second).OrDefault(time.Minute))
+	assert.Equal(t, time.Minute, Duration{}.OrDefault(time.Minute))
+}
+
+func TestDuration_String(t *testing.T) {
+	assert.Equal(t, "Some(1m30s)", SomeDuration(90*time.Second).String())
+	assert.Equal(t, "None", fmt.Sprint(Duration{}))
+}
+
+func TestDuration_JSON(t *testing.T) {
+	data, err := json.Marshal(serverConfig{Timeout: SomeDuration(30 * time.Second)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"timeout":"30s","interval":null}`, string(data))
+
+	var cfg serverConfig
+	assert.NoError(t, json.Unmarshal([]byte(`{"timeout":"1m","interval":1000000000}`), &cfg))
+	assert.Equal(t, SomeDuration(time.Minute), cfg.Timeout)
+	assert.Equal(t, SomeDuration(time.Second), cfg.Interval)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"timeout":null}`), &cfg))
+	assert.True(t, cfg.Timeout.IsNone())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":"soon"}`), &cfg))
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":true}`), &cfg))
+
+	// nanoseconds beyond 2^53 are decoded exactly
+	assert.NoError(t, json.Unmarshal([]byte(`{"timeout":9007199254740993}`), &cfg))
+	assert.Equal(t, SomeDuration(9007199254740993), cfg.Timeout)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":1.5}`), &cfg))
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":1e9}`), &cfg))
+}
+
+func TestDuration_Text(t *testing.T) {
+	data, err := SomeDuration(time.Millisecond).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1ms", string(data))
+
+	data, err = Duration{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(data))
+
+	var d Duration
+	assert.NoError(t, d.UnmarshalText([]byte("2h")))
+	assert.Equal(t, SomeDuration(2*time.Hour), d)
+}
+
+func TestDuration_YAML(t *testing.T) {
+	data, err := yaml.Marshal(serverConfig{Timeout: SomeDuration(30 * time.Second)})
+	assert.NoError(t, err)
+	assert.Equal(t, "timeout: 30s\ninterval: null\n", string(data))
+
+	var cfg serverConfig
+	assert.NoError(t, yaml.Unmarshal([]byte("timeout: 5m\ninterval: null\n"), &cfg))
+	assert.Equal(t, SomeDuration(5*time.Minute), cfg.Timeout)
+	assert.True(t, cfg.Interval.IsNone())
+
+	assert.NoError(t, yaml.Unmarshal([]byte("timeout: 1000000000\ninterval: 9007199254740993\n"), &cfg))
+	assert.Equal(t, SomeDuration(time.Second), cfg.Timeout)
+	assert.Equal(t, SomeDuration(9007199254740993), cfg.Interval)
+
+	assert.Error(t, yaml.Unmarshal([]byte("timeout: 1.5\n"), &cfg))
+	assert.Error(t, yaml.Unmarshal([]byte("timeout: true\n"), &cfg))
+}
+
+func TestAddOpt(t *testing.T) {
+	assert.Equal(t, Some(3*time.Second), AddOpt(Some(time.Second), Some(2*time.Second)))
+	assert.Equal(t, None[time.Duration](), AddOpt(Some(time.Second), None[time.Duration]()))
+}
@@ -0,0 +1,184 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync/atomic"
+)
+
+var jsonNull = []byte("null")
+
+// Encoding determines how an Option is represented in JSON.
+type Encoding int32
+
+const (
+	// EncodeNull encodes None as null and Some as the value itself. This is the
+	// default Encoding.
+	EncodeNull Encoding = iota
+	// EncodeExplicit encodes an Option as an object that explicitly indicates
+	// presence, ie {"present":true,"value":"Billy"} or {"present":false}. This is
+	// useful when null is a meaningful value or the API contract requires it.
+	EncodeExplicit
+)
+
+var currentEncoding atomic.Int32
+
+// SetEncoding sets the process-wide Encoding used when marshalling and
+// unmarshalling Option to and from JSON.
+//
+// SetEncoding is intended to be called once during program initialization. It is
+// safe for concurrent use, but changing the Encoding while values are being
+// encoded or decoded will lead to inconsistent output. Individual fields can use
+// Nullable or Explicit to opt out of the process-wide Encoding.
+func SetEncoding(enc Encoding) {
+	currentEncoding.Store(int32(enc))
+}
+
+func jsonEncoding() Encoding {
+	return Encoding(currentEncoding.Load())
+}
+
+// Nullable is an Option that is always encoded using EncodeNull regardless of the
+// process-wide Encoding.
+type Nullable[T any] struct {
+	Option[T]
+}
+
+// MarshalJSON marshals the Nullable to JSON using EncodeNull.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Option, EncodeNull)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Nullable using EncodeNull.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&n.Option, data, EncodeNull)
+}
+
+// Explicit is an Option that is always encoded using EncodeExplicit regardless of
+// the process-wide Encoding.
+type Explicit[T any] struct {
+	Option[T]
+}
+
+// MarshalJSON marshals the Explicit to JSON using EncodeExplicit.
+func (e Explicit[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e.Option, EncodeExplicit)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Explicit using EncodeExplicit.
+func (e *Explicit[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&e.Option, data, EncodeExplicit)
+}
+
+// Tracked is an Option that records whether its key was present while decoding
+// JSON, which allows an explicit null to be told apart from a missing key, ie
+// "clear the field" vs "don't touch it". Tracked is always encoded using
+// EncodeNull regardless of the process-wide Encoding.
+//
+// The Tracked must be reset to its zero value before being reused to decode
+// another document since a missing key leaves it untouched.
+type Tracked[T any] struct {
+	Option[T]
+	present bool
+}
+
+// WasPresent returns a boolean indicating if the key was present when decoding,
+// including when it was explicitly null, or if the Tracked is Some.
+func (t Tracked[T]) WasPresent() bool {
+	return t.present || t.exists
+}
+
+// WasNull returns a boolean indicating if the key was present but explicitly set
+// to null when decoding.
+func (t Tracked[T]) WasNull() bool {
+	return t.present && !t.exists
+}
+
+// IsZero returns a boolean indicating if the Tracked is None and the key wasn't
+// present. With the `omitzero` tag option a missing key is omitted while an
+// explicit null is preserved when re-encoding.
+func (t Tracked[T]) IsZero() bool {
+	return !t.WasPresent()
+}
+
+// MarshalJSON marshals the Tracked to JSON using EncodeNull.
+func (t Tracked[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(t.Option, EncodeNull)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Tracked using EncodeNull and records that
+// the key was present.
+func (t *Tracked[T]) UnmarshalJSON(data []byte) error {
+	if err := unmarshalJSON(&t.Option, data, EncodeNull); err != nil {
+		return err
+	}
+	t.present = true
+	return nil
+}
+
+type explicitJSON[T any] struct {
+	Present *bool `json:"present"`
+	Value   *T    `json:"value,omitempty"`
+}
+
+func marshalJSON[T any](o Option[T], enc Encoding) ([]byte, error) {
+	if enc == EncodeExplicit {
+		present := o.exists
+		e := explicitJSON[T]{Present: &present}
+		if o.exists {
+			e.Value = &o.val
+		}
+		return json.Marshal(e)
+	}
+
+	if !o.exists {
+		return json.Marshal(nil)
+	}
+	b, err := json.Marshal(o.val)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func unmarshalJSON[T any](o *Option[T], data []byte, enc Encoding) error {
+	if len(data) <= 0 || bytes.Equal(data, jsonNull) {
+		*o = None[T]()
+		return nil
+	}
+
+	if enc == EncodeExplicit {
+		var e explicitJSON[T]
+		if err := json.Unmarshal(data, &e); err != nil {
+			return err
+		}
+		if e.Present == nil {
+			return errors.New("explicitly encoded Option is missing the present field")
+		}
+		if !*e.Present {
+			*o = None[T]()
+			return nil
+		}
+		var v T
+		if e.Value != nil {
+			v = *e.Value
+		}
+		if reflect.TypeOf(any(v)) == nil {
+			// Some doesn't accept a nil interface value, which is all a missing
+			// or null value decodes to when T is an interface type.
+			return errors.New("explicitly encoded Option is present but has no value")
+		}
+		*o = Some(v)
+		return nil
+	}
+
+	var v T
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
@@ -0,0 +1,119 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetEncoding(t *testing.T) {
+	defer SetEncoding(EncodeNull)
+
+	SetEncoding(EncodeExplicit)
+	data, err := json.Marshal(Some("Billy"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"present":true,"value":"Billy"}`, string(data))
+
+	data, err = json.Marshal(None[string]())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"present":false}`, string(data))
+
+	var opt Option[string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"present":true,"value":"Billy"}`), &opt))
+	assert.Equal(t, Some("Billy"), opt)
+	assert.NoError(t, json.Unmarshal([]byte(`{"present":false}`), &opt))
+	assert.Equal(t, None[string](), opt)
+	assert.Error(t, json.Unmarshal([]byte(`{"value":"Billy"}`), &opt))
+	assert.Error(t, json.Unmarshal([]byte(`"Billy"`), &opt))
+
+	SetEncoding(EncodeNull)
+	data, err = json.Marshal(Some("Billy"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"Billy"`, string(data))
+}
+
+func TestSetEncoding_ExplicitMissingValue(t *testing.T) {
+	defer SetEncoding(EncodeNull)
+	SetEncoding(EncodeExplicit)
+
+	var opt Option[any]
+	assert.Error(t, json.Unmarshal([]byte(`{"present":true}`), &opt))
+	assert.Error(t, json.Unmarshal([]byte(`{"present":true,"value":null}`), &opt))
+	assert.NoError(t, json.Unmarshal([]byte(`{"present":true,"value":"Billy"}`), &opt))
+	assert.Equal(t, Some[any]("Billy"), opt)
+
+	var ptr Option[*int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"present":true,"value":null}`), &ptr))
+	assert.True(t, ptr.IsSome())
+}
+
+func TestNullable(t *testing.T) {
+	defer SetEncoding(EncodeNull)
+	SetEncoding(EncodeExplicit)
+
+	data, err := json.Marshal(Nullable[int]{Some(42)})
+	assert.NoError(t, err)
+	assert.Equal(t, `42`, string(data))
+
+	data, err = json.Marshal(Nullable[int]{None[int]()})
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	var n Nullable[int]
+	assert.NoError(t, json.Unmarshal([]byte(`42`), &n))
+	assert.Equal(t, Some(42), n.Option)
+}
+
+func TestExplicit(t *testing.T) {
+	type payload struct {
+		Age Explicit[int] `json:"age"`
+	}
+
+	data, err := json.Marshal(payload{Age: Explicit[int]{Some(42)}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"age":{"present":true,"value":42}}`, string(data))
+
+	var p payload
+	assert.NoError(t, json.Unmarshal([]byte(`{"age":{"present":true,"value":42}}`), &p))
+	assert.Equal(t, Some(42), p.Age.Option)
+	assert.Equal(t, 42, p.Age.Unwrap())
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"age":{"present":false}}`), &p))
+	assert.True(t, p.Age.IsNone())
+}
+
+func TestTracked(t *testing.T) {
+	type payload struct {
+		Name Tracked[string] `json:"name,omitzero"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		present bool
+		null    bool
+		output  string
+	}{
+		{name: "Missing", input: `{}`, output: `{}`},
+		{name: "Null", input: `{"name":null}`, present: true, null: true, output: `{"name":null}`},
+		{name: "Value", input: `{"name":"Billy"}`, present: true, output: `{"name":"Billy"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p payload
+			assert.NoError(t, json.Unmarshal([]byte(tt.input), &p))
+			assert.Equal(t, tt.present, p.Name.WasPresent())
+			assert.Equal(t, tt.null, p.Name.WasNull())
+
+			data, err := json.Marshal(p)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.output, string(data))
+		})
+	}
+
+	p := payload{Name: Tracked[string]{Option: Some("Billy")}}
+	assert.True(t, p.Name.WasPresent())
+	assert.False(t, p.Name.WasNull())
+}
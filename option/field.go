@@ -0,0 +1,114 @@
+package option
+
+import (
+	"reflect"
+	"strings"
+)
+
+// anyOption is implemented by every Option type and allows the value of an
+// Option to be accessed without knowing its type parameter.
+type anyOption interface {
+	anyValue() (any, bool)
+}
+
+func (o Option[T]) anyValue() (any, bool) {
+	return o.val, o.exists
+}
+
+// Field traverses the exported fields of v by a dotted path (ie "Address.City")
+// and returns Some with the value found at the end of the path. Pointers,
+// interfaces, and Options encountered along the path are followed transparently.
+//
+// None is returned if any segment of the path is nil, None, doesn't exist, isn't
+// exported, or if the value found can't be converted to T. An empty path refers
+// to v itself.
+//
+// Field relies on reflection and is intended for generic use cases such as
+// templating and rule engines operating over arbitrary structs. When the type is
+// known at compile time, accessing the fields directly is much faster and safer.
+func Field[T any](v any, path string) Option[T] {
+	cur := reflect.ValueOf(v)
+	if path != "" {
+		for _, name := range strings.Split(path, ".") {
+			var ok bool
+			cur, ok = indirect(cur)
+			if !ok || cur.Kind() != reflect.Struct {
+				return None[T]()
+			}
+			sf, found := cur.Type().FieldByName(name)
+			if !found || !sf.IsExported() {
+				return None[T]()
+			}
+			// A promoted field is unreachable through a nil embedded pointer.
+			next, err := cur.FieldByIndexErr(sf.Index)
+			if err != nil {
+				return None[T]()
+			}
+			cur = next
+		}
+	}
+
+	// The value is checked against T before each layer is unwrapped so that a
+	// T that is itself a pointer or Option can be retrieved.
+	for {
+		if !cur.IsValid() || !cur.CanInterface() || isNil(cur) {
+			return None[T]()
+		}
+		if val, ok := cur.Interface().(T); ok {
+			return Some(val)
+		}
+		next, ok := unwrap(cur)
+		if !ok {
+			return None[T]()
+		}
+		cur = next
+	}
+}
+
+// indirect follows pointers, interfaces, and Options until reaching a value that
+// is none of those. A boolean is returned indicating a value was reached, it is
+// false if a nil or None was encountered.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for {
+		if !v.IsValid() || isNil(v) {
+			return v, false
+		}
+		next, ok := unwrap(v)
+		if !ok {
+			return v, true
+		}
+		v = next
+	}
+}
+
+// unwrap removes a single layer of indirection from v. The boolean is false
+// if v isn't a pointer, interface, or Option, or if there is no value inside.
+func unwrap(v reflect.Value) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		return v.Elem(), true
+	case reflect.Struct:
+		if !v.CanInterface() {
+			return v, false
+		}
+		if opt, ok := v.Interface().(anyOption); ok {
+			val, exists := opt.anyValue()
+			if !exists {
+				return reflect.Value{}, true
+			}
+			return reflect.ValueOf(val), true
+		}
+	}
+	return v, false
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
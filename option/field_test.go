@@ -0,0 +1,67 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City  string
+	Zip   Option[string]
+	state string
+}
+
+type customer struct {
+	Name     string
+	Address  *address
+	Billing  Option[*address]
+	Nickname Option[string]
+	Tags     []string
+	Any      any
+}
+
+func TestField_NilEmbeddedPointer(t *testing.T) {
+	type outer struct {
+		*address
+		Name string
+	}
+
+	assert.Equal(t, None[string](), Field[string](outer{Name: "Billy"}, "City"))
+	assert.Equal(t, Some("Billy"), Field[string](outer{Name: "Billy"}, "Name"))
+	assert.Equal(t, Some("Springfield"), Field[string](outer{address: &address{City: "Springfield"}}, "City"))
+}
+
+func TestField(t *testing.T) {
+	c := customer{
+		Name: "Billy Bob",
+		Address: &address{
+			City:  "Springfield",
+			Zip:   Some("12345"),
+			state: "IL",
+		},
+		Billing: Some(&address{City: "Shelbyville"}),
+		Any:     address{City: "Capital City"},
+	}
+
+	assert.Equal(t, Some("Billy Bob"), Field[string](c, "Name"))
+	assert.Equal(t, Some("Billy Bob"), Field[string](&c, "Name"))
+	assert.Equal(t, Some("Springfield"), Field[string](c, "Address.City"))
+	assert.Equal(t, Some("12345"), Field[string](c, "Address.Zip"))
+	assert.Equal(t, Some(Some("12345")), Field[Option[string]](c, "Address.Zip"))
+	assert.Equal(t, Some("Shelbyville"), Field[string](c, "Billing.City"))
+	assert.Equal(t, Some("Capital City"), Field[string](c, "Any.City"))
+	assert.Equal(t, Some(c.Address), Field[*address](c, "Address"))
+	assert.Equal(t, Some(c), Field[customer](c, ""))
+
+	// Absent, nil, unexported, and mismatched types
+	assert.True(t, Field[string](c, "Nickname").IsNone())
+	assert.True(t, Field[string](c, "Billing.Zip").IsNone())
+	assert.True(t, Field[string](c, "Address.state").IsNone())
+	assert.True(t, Field[string](c, "Missing").IsNone())
+	assert.True(t, Field[string](c, "Name.Length").IsNone())
+	assert.True(t, Field[int](c, "Name").IsNone())
+	assert.True(t, Field[[]string](c, "Tags").IsNone())
+	assert.True(t, Field[string](nil, "Name").IsNone())
+	assert.True(t, Field[string](customer{}, "Address.City").IsNone())
+}
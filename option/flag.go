@@ -0,0 +1,64 @@
+package option
+
+import (
+	"flag"
+	"reflect"
+)
+
+// Flag is an Option that implements flag.Value and flag.Getter, so a command line
+// flag that was never provided is None while a flag that was explicitly set is
+// Some, even when set to the zero value of T.
+//
+// Values are parsed the same as UnmarshalText, except an empty string is parsed
+// as Some("") for string flags since it was explicitly provided. Flags of type
+// bool can be set without a value, ie -verbose. The zero value is an unset Flag
+// ready to use.
+type Flag[T any] struct {
+	Option[T]
+}
+
+// FlagVar defines a Flag with the provided name and usage on the FlagSet and
+// returns it. If fs is nil the flag is defined on flag.CommandLine.
+func FlagVar[T any](fs *flag.FlagSet, name, usage string) *Flag[T] {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	f := &Flag[T]{}
+	fs.Var(f, name, usage)
+	return f
+}
+
+// Set implements the flag.Value interface, parsing s into the value and setting
+// the Flag to Some.
+func (f *Flag[T]) Set(s string) error {
+	v, err := unmarshalText[T]([]byte(s))
+	if err != nil {
+		return err
+	}
+	f.Option = Some(v)
+	return nil
+}
+
+// String implements the flag.Value interface. None is formatted as an empty
+// string so unset flags don't show a default in usage messages.
+func (f *Flag[T]) String() string {
+	if f == nil || !f.exists {
+		return ""
+	}
+	text, err := marshalText(f.val)
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Get implements the flag.Getter interface returning the Option.
+func (f *Flag[T]) Get() any {
+	return f.Option
+}
+
+// IsBoolFlag reports whether the Flag is a boolean flag that can be set without a
+// value, as understood by the flag package.
+func (f *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
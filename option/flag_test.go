@@ -0,0 +1,54 @@
+package option
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+func TestFlag(t *testing.T) {
+	fs := newFlagSet()
+	port := FlagVar[int](fs, "port", "port to listen on")
+	host := FlagVar[string](fs, "host", "host to bind")
+	verbose := FlagVar[bool](fs, "verbose", "verbose logging")
+	debug := FlagVar[bool](fs, "debug", "debug mode")
+
+	assert.NoError(t, fs.Parse([]string{"-port", "0", "-host=", "-verbose"}))
+	assert.Equal(t, Some(0), port.Option)
+	assert.Equal(t, Some(""), host.Option)
+	assert.Equal(t, Some(true), verbose.Option)
+	assert.Equal(t, None[bool](), debug.Option)
+
+	assert.Equal(t, "0", port.String())
+	assert.Equal(t, "", debug.String())
+	assert.Equal(t, Some(0), fs.Lookup("port").Value.(flag.Getter).Get())
+}
+
+func TestFlag_Duration(t *testing.T) {
+	fs := newFlagSet()
+	timeout := FlagVar[time.Duration](fs, "timeout", "request timeout")
+	assert.NoError(t, fs.Parse([]string{"-timeout=30s"}))
+	assert.Equal(t, Some(30*time.Second), timeout.Option)
+	assert.Equal(t, "30s", timeout.String())
+}
+
+func TestFlag_Invalid(t *testing.T) {
+	fs := newFlagSet()
+	port := FlagVar[int](fs, "port", "port to listen on")
+	assert.Error(t, fs.Parse([]string{"-port", "eighty"}))
+	assert.True(t, port.IsNone())
+}
+
+func TestFlag_IsBoolFlag(t *testing.T) {
+	assert.True(t, (&Flag[bool]{}).IsBoolFlag())
+	assert.False(t, (&Flag[string]{}).IsBoolFlag())
+}
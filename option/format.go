@@ -0,0 +1,35 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String returns Some(value) when the Option contains a value, otherwise None.
+func (o Option[T]) String() string {
+	if !o.exists {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.val)
+}
+
+// Format implements fmt.Formatter. None is always formatted as None and Some is
+// formatted as Some(value) where the value is formatted using the same verb and
+// flags, so %+v and %q for example apply to the contained value. The %#v verb
+// formats the Option as Go syntax, ie option.Some[string]("Billy").
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		typ := reflect.TypeFor[T]().String()
+		if !o.exists {
+			fmt.Fprintf(f, "option.None[%s]()", typ)
+			return
+		}
+		fmt.Fprintf(f, "option.Some[%s](%#v)", typ, o.val)
+		return
+	}
+	if !o.exists {
+		_, _ = f.Write([]byte("None"))
+		return
+	}
+	fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.val)
+}
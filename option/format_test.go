@@ -0,0 +1,49 @@
+package option
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestOption_String(t *testing.T) {
+	assert.Equal(t, "Some(Billy)", Some("Billy").String())
+	assert.Equal(t, "None", None[string]().String())
+	assert.Equal(t, "Some(Some(1))", Some(Some(1)).String())
+}
+
+func TestOption_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		val      any
+		expected string
+	}{
+		{name: "None v", format: "%v", val: None[int](), expected: "None"},
+		{name: "None s", format: "%s", val: None[int](), expected: "None"},
+		{name: "Some v", format: "%v", val: Some(point{1, 2}), expected: "Some({1 2})"},
+		{name: "Some +v", format: "%+v", val: Some(point{1, 2}), expected: "Some({X:1 Y:2})"},
+		{name: "Some q", format: "%q", val: Some("Billy"), expected: `Some("Billy")`},
+		{name: "Some width", format: "%5d", val: Some(42), expected: "Some(   42)"},
+		{name: "Some precision", format: "%.2f", val: Some(1.2345), expected: "Some(1.23)"},
+		{name: "Some #v", format: "%#v", val: Some("Billy"), expected: `option.Some[string]("Billy")`},
+		{name: "None #v", format: "%#v", val: None[point](), expected: "option.None[option.point]()"},
+		{name: "Nested", format: "%v", val: Some(None[int]()), expected: "Some(None)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, test.val))
+		})
+	}
+
+	type wrapper struct {
+		Name Option[string]
+	}
+	assert.Equal(t, "{Name:Some(Billy)}", fmt.Sprintf("%+v", wrapper{Name: Some("Billy")}))
+}
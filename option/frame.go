@@ -0,0 +1,165 @@
+package option
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+const (
+	frameVersion = 1
+	frameNone    = 0
+	frameSome    = 1
+)
+
+var (
+	// ErrInvalidFrame is returned when decoding data that isn't a valid encoded
+	// Option.
+	ErrInvalidFrame = errors.New("invalid encoded Option frame")
+	// ErrUnsupportedVersion is returned when decoding data that was encoded with a
+	// version of the framing this package doesn't understand.
+	ErrUnsupportedVersion = errors.New("unsupported encoded Option version")
+)
+
+// Codec marshals and unmarshals values to and from bytes. A Codec is used to
+// encode the value of an Option when using Encode and Decode.
+type Codec[T any] interface {
+	Marshal(val T) ([]byte, error)
+	Unmarshal(data []byte) (T, error)
+}
+
+// StreamCodec encodes and decodes values to and from a stream. A StreamCodec is
+// used when using EncodeTo and DecodeFrom.
+type StreamCodec[T any] interface {
+	Encode(w io.Writer, val T) error
+	Decode(r io.Reader) (T, error)
+}
+
+// JSONCodec is a Codec and StreamCodec that uses encoding/json.
+type JSONCodec[T any] struct{}
+
+// Marshal marshals the value to JSON.
+func (JSONCodec[T]) Marshal(val T) ([]byte, error) {
+	return json.Marshal(val)
+}
+
+// Unmarshal unmarshalls JSON to a value.
+func (JSONCodec[T]) Unmarshal(data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
+
+// Encode writes the value to w as JSON.
+func (JSONCodec[T]) Encode(w io.Writer, val T) error {
+	return json.NewEncoder(w).Encode(val)
+}
+
+// Decode reads a JSON value from r.
+func (JSONCodec[T]) Decode(r io.Reader) (T, error) {
+	var v T
+	err := json.NewDecoder(r).Decode(&v)
+	return v, err
+}
+
+// Encode encodes an Option into a compact binary frame suitable for storing in
+// key-value stores such as Redis or Badger. The frame consists of a version byte,
+// a presence byte, and when the Option is Some the value encoded by the Codec.
+func Encode[T any](opt Option[T], codec Codec[T]) ([]byte, error) {
+	if !opt.exists {
+		return []byte{frameVersion, frameNone}, nil
+	}
+	payload, err := codec.Marshal(opt.val)
+	if err != nil {
+		return nil, fmt.Errorf("encode Option value: %w", err)
+	}
+	frame := make([]byte, 0, len(payload)+2)
+	frame = append(frame, frameVersion, frameSome)
+	return append(frame, payload...), nil
+}
+
+// Decode decodes a binary frame produced by Encode back into an Option.
+func Decode[T any](data []byte, codec Codec[T]) (Option[T], error) {
+	if len(data) < 2 {
+		return None[T](), ErrInvalidFrame
+	}
+	some, err := parseHeader(data[0], data[1])
+	if err != nil || !some {
+		return None[T](), err
+	}
+	val, err := codec.Unmarshal(data[2:])
+	if err != nil {
+		return None[T](), fmt.Errorf("decode Option value: %w", err)
+	}
+	return someFrameValue(val)
+}
+
+// EncodeTo is the streaming variant of Encode, writing the frame to w instead of
+// buffering it in memory. This is preferable for large values.
+func EncodeTo[T any](w io.Writer, opt Option[T], codec StreamCodec[T]) error {
+	header := []byte{frameVersion, frameNone}
+	if opt.exists {
+		header[1] = frameSome
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if !opt.exists {
+		return nil
+	}
+	if err := codec.Encode(w, opt.val); err != nil {
+		return fmt.Errorf("encode Option value: %w", err)
+	}
+	return nil
+}
+
+// DecodeFrom is the streaming variant of Decode, reading a frame written by
+// EncodeTo from r.
+//
+// Depending on the StreamCodec, data beyond the end of the frame may be consumed
+// from r (JSONCodec buffers reads for example), so the frame should be the only
+// contents of r.
+func DecodeFrom[T any](r io.Reader, codec StreamCodec[T]) (Option[T], error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return None[T](), ErrInvalidFrame
+		}
+		return None[T](), err
+	}
+	some, err := parseHeader(header[0], header[1])
+	if err != nil || !some {
+		return None[T](), err
+	}
+	val, err := codec.Decode(r)
+	if err != nil {
+		return None[T](), fmt.Errorf("decode Option value: %w", err)
+	}
+	return someFrameValue(val)
+}
+
+// someFrameValue returns Some with the value decoded from a present frame. A
+// present frame can't hold a nil interface value, since Some can't be created
+// with one, so the frame is reported as invalid.
+func someFrameValue[T any](val T) (Option[T], error) {
+	if reflect.TypeOf(val) == nil {
+		return None[T](), fmt.Errorf("%w: present frame has a nil value", ErrInvalidFrame)
+	}
+	return Some(val), nil
+}
+
+func parseHeader(version, presence byte) (bool, error) {
+	if version != frameVersion {
+		return false, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	switch presence {
+	case frameNone:
+		return false, nil
+	case frameSome:
+		return true, nil
+	default:
+		return false, ErrInvalidFrame
+	}
+}
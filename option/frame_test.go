@@ -0,0 +1,77 @@
+package option
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	codec := JSONCodec[string]{}
+
+	data, err := Encode(Some("Billy"), codec)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 1, '"', 'B', 'i', 'l', 'l', 'y', '"'}, data)
+
+	opt, err := Decode(data, codec)
+	assert.NoError(t, err)
+	assert.Equal(t, Some("Billy"), opt)
+
+	data, err = Encode(None[string](), codec)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0}, data)
+
+	opt, err = Decode(data, codec)
+	assert.NoError(t, err)
+	assert.Equal(t, None[string](), opt)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	codec := JSONCodec[string]{}
+
+	_, err := Decode([]byte{}, codec)
+	assert.ErrorIs(t, err, ErrInvalidFrame)
+
+	_, err = Decode([]byte{2, 1}, codec)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+
+	_, err = Decode([]byte{1, 5}, codec)
+	assert.ErrorIs(t, err, ErrInvalidFrame)
+
+	_, err = Decode([]byte{1, 1, '{'}, codec)
+	assert.Error(t, err)
+}
+
+func TestDecode_NilPayload(t *testing.T) {
+	codec := JSONCodec[any]{}
+	frame := []byte{1, 1, 'n', 'u', 'l', 'l'}
+
+	opt, err := Decode(frame, codec)
+	assert.ErrorIs(t, err, ErrInvalidFrame)
+	assert.True(t, opt.IsNone())
+
+	opt, err = DecodeFrom(bytes.NewReader(frame), codec)
+	assert.ErrorIs(t, err, ErrInvalidFrame)
+	assert.True(t, opt.IsNone())
+}
+
+func TestEncodeToDecodeFrom(t *testing.T) {
+	codec := JSONCodec[[]int]{}
+
+	var buf bytes.Buffer
+	assert.NoError(t, EncodeTo(&buf, Some([]int{1, 2, 3}), codec))
+	opt, err := DecodeFrom(&buf, codec)
+	assert.NoError(t, err)
+	assert.Equal(t, Some([]int{1, 2, 3}), opt)
+
+	buf.Reset()
+	assert.NoError(t, EncodeTo(&buf, None[[]int](), codec))
+	assert.Equal(t, []byte{1, 0}, buf.Bytes())
+	opt, err = DecodeFrom(&buf, codec)
+	assert.NoError(t, err)
+	assert.True(t, opt.IsNone())
+
+	_, err = DecodeFrom(&buf, codec)
+	assert.ErrorIs(t, err, ErrInvalidFrame)
+}
@@ -0,0 +1,71 @@
+package option
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// gqlMarshaler matches the graphql.Marshaler interface used by gqlgen.
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+// gqlUnmarshaler matches the graphql.Unmarshaler interface used by gqlgen.
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+// MarshalGQL implements the gqlgen graphql.Marshaler interface so an Option can
+// be bound to a nullable GraphQL scalar. None is written as null. If the value
+// implements graphql.Marshaler it is used, otherwise the value is written as JSON.
+//
+// Like the marshalers provided by gqlgen, MarshalGQL panics if the value can't be
+// encoded as JSON.
+func (o Option[T]) MarshalGQL(w io.Writer) {
+	if !o.exists {
+		_, _ = w.Write(jsonNull)
+		return
+	}
+	if m, ok := any(o.val).(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+	data, err := json.Marshal(o.val)
+	if err != nil {
+		panic(err)
+	}
+	_, _ = w.Write(data)
+}
+
+// UnmarshalGQL implements the gqlgen graphql.Unmarshaler interface. A nil input,
+// which is how gqlgen represents null, is decoded as None. If *T implements
+// graphql.Unmarshaler it is used, if the input is already a T it is used as is,
+// otherwise the input is converted to T by round-tripping it through JSON, which
+// handles the numbers and maps gqlgen produces for input values.
+func (o *Option[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		*o = None[T]()
+		return nil
+	}
+	var val T
+	if u, ok := any(&val).(gqlUnmarshaler); ok {
+		if err := u.UnmarshalGQL(v); err != nil {
+			return err
+		}
+		*o = Some(val)
+		return nil
+	}
+	if t, ok := v.(T); ok {
+		*o = Some(t)
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*o = Some(val)
+	return nil
+}
@@ -0,0 +1,81 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type status string
+
+func (s status) MarshalGQL(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "%q", strings.ToUpper(string(s)))
+}
+
+func (s *status) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("status must be a string")
+	}
+	*s = status(strings.ToLower(str))
+	return nil
+}
+
+func TestOption_MarshalGQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		marshal  func(w io.Writer)
+		expected string
+	}{
+		{name: "None", marshal: None[int]().MarshalGQL, expected: "null"},
+		{name: "Int", marshal: Some(42).MarshalGQL, expected: "42"},
+		{name: "String", marshal: Some("Billy").MarshalGQL, expected: `"Billy"`},
+		{name: "Marshaler", marshal: Some(status("active")).MarshalGQL, expected: `"ACTIVE"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.marshal(&buf)
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+
+	assert.Panics(t, func() {
+		Some(func() {}).MarshalGQL(io.Discard)
+	})
+}
+
+func TestOption_UnmarshalGQL(t *testing.T) {
+	opt := Some(1)
+	assert.NoError(t, opt.UnmarshalGQL(nil))
+	assert.True(t, opt.IsNone())
+
+	assert.NoError(t, opt.UnmarshalGQL(int64(42)))
+	assert.Equal(t, Some(42), opt)
+
+	assert.NoError(t, opt.UnmarshalGQL(json.Number("43")))
+	assert.Equal(t, Some(43), opt)
+
+	var s Option[string]
+	assert.NoError(t, s.UnmarshalGQL("Billy"))
+	assert.Equal(t, Some("Billy"), s)
+
+	var st Option[status]
+	assert.NoError(t, st.UnmarshalGQL("ACTIVE"))
+	assert.Equal(t, Some(status("active")), st)
+	assert.Error(t, st.UnmarshalGQL(42))
+
+	var p Option[person]
+	assert.NoError(t, p.UnmarshalGQL(map[string]any{"firstName": "Billy", "middleName": nil}))
+	assert.Equal(t, "Billy", p.Unwrap().FirstName)
+	assert.True(t, p.Unwrap().MiddleName.IsNone())
+
+	assert.Error(t, opt.UnmarshalGQL("abc"))
+}
@@ -0,0 +1,26 @@
+package option
+
+import (
+	"hash/maphash"
+)
+
+// Hash returns a hash of the Option using the provided seed. Options that are
+// Equal produce the same hash for the same seed, and None never hashes the same
+// as Some of the zero value, so Hash is suitable for hash-based sets and cache
+// keys. Like maphash.Comparable the hash is only stable within a single process,
+// and Hash panics if the value contains an interface holding an uncomparable type.
+//
+// An Option of a comparable type is itself comparable, None values always hold the
+// zero value of T, so Options can also be used directly as map keys and compared
+// with ==, which is equivalent to Equal.
+func Hash[T comparable](seed maphash.Seed, opt Option[T]) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	if !opt.exists {
+		h.WriteByte(frameNone)
+		return h.Sum64()
+	}
+	h.WriteByte(frameSome)
+	maphash.WriteComparable(&h, opt.val)
+	return h.Sum64()
+}
@@ -0,0 +1,34 @@
+package option
+
+import (
+	"hash/maphash"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	assert.Equal(t, Hash(seed, Some("Billy")), Hash(seed, Some("Billy")))
+	assert.Equal(t, Hash(seed, None[string]()), Hash(seed, None[string]()))
+	assert.NotEqual(t, Hash(seed, Some("Billy")), Hash(seed, Some("Bob")))
+	assert.NotEqual(t, Hash(seed, None[int]()), Hash(seed, Some(0)))
+
+	var taken Option[int] = Some(42)
+	taken.Take()
+	assert.Equal(t, Hash(seed, None[int]()), Hash(seed, taken))
+	assert.Equal(t, None[int](), taken)
+}
+
+func TestOption_MapKey(t *testing.T) {
+	seen := map[Option[int]]int{}
+	seen[Some(1)]++
+	seen[Some(1)]++
+	seen[None[int]()]++
+	seen[Some(0)]++
+
+	assert.Len(t, seen, 3)
+	assert.Equal(t, 2, seen[Some(1)])
+	assert.Equal(t, 1, seen[None[int]()])
+}
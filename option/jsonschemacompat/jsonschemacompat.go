@@ -0,0 +1,83 @@
+// Package jsonschemacompat teaches github.com/invopop/jsonschema that an Option
+// is a nullable value of its type, so schemas generated from structs containing
+// Options describe the JSON they are actually encoded as instead of an opaque
+// object.
+//
+// The schemas assume Options are encoded using option.EncodeNull, which is the
+// default encoding.
+//
+// The jsonschema package decides whether a property is required from the
+// omitempty option of its json tag and doesn't understand omitzero. Tag Options
+// that are omitted when None with both so the property is optional in the schema:
+//
+//	Age option.Option[int] `json:"age,omitempty,omitzero"`
+package jsonschemacompat
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+	"github.com/jkratz55/gonads/option"
+)
+
+var optionPkgPath = reflect.TypeFor[option.Option[int]]().PkgPath()
+
+// NewReflector creates a jsonschema.Reflector that describes Options as nullable
+// values.
+func NewReflector() *jsonschema.Reflector {
+	r := &jsonschema.Reflector{}
+	Configure(r)
+	return r
+}
+
+// Configure sets the Mapper of the Reflector so that option.Option,
+// option.Nullable, and option.Tracked are described as a nullable value of their
+// type, ie {"oneOf":[{"type":"integer"},{"type":"null"}]} for an Option[int].
+// Any Mapper already set on the Reflector is still used for other types.
+//
+// The schema of the value is inlined rather than referenced from the
+// definitions, so Configure should be called after the Reflector has been
+// otherwise configured. Options of recursive types aren't supported.
+func Configure(r *jsonschema.Reflector) {
+	next := r.Mapper
+	inline := *r
+	inline.DoNotReference = true
+	inline.ExpandedStruct = false
+	inline.Anonymous = true
+
+	mapper := func(t reflect.Type) *jsonschema.Schema {
+		elem, ok := optionElem(t)
+		if !ok {
+			if next != nil {
+				return next(t)
+			}
+			return nil
+		}
+		s := inline.ReflectFromType(elem)
+		s.Version = ""
+		s.ID = ""
+		s.Definitions = nil
+		return &jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{s, {Type: "null"}},
+		}
+	}
+	inline.Mapper = mapper
+	r.Mapper = mapper
+}
+
+// optionElem returns the type of the value contained in t if t is an Option type
+// encoded as null or the value.
+func optionElem(t reflect.Type) (reflect.Type, bool) {
+	if t.PkgPath() != optionPkgPath {
+		return nil, false
+	}
+	name := t.Name()
+	if !strings.HasPrefix(name, "Option[") && !strings.HasPrefix(name, "Nullable[") &&
+		!strings.HasPrefix(name, "Tracked[") {
+		return nil, false
+	}
+	return reflectopt.OptionElem(t)
+}
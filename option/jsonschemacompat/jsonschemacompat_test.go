@@ -0,0 +1,65 @@
+package jsonschemacompat
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+type address struct {
+	City string `json:"city"`
+}
+
+type user struct {
+	Name    string                   `json:"name"`
+	Age     option.Option[int]       `json:"age,omitempty,omitzero"`
+	Born    option.Option[time.Time] `json:"born,omitempty,omitzero"`
+	Address option.Option[address]   `json:"address,omitempty,omitzero"`
+	Nick    option.Nullable[string]  `json:"nick"`
+}
+
+func property(t *testing.T, s *jsonschema.Schema, name string) string {
+	t.Helper()
+	prop, ok := s.Definitions["user"].Properties.Get(name)
+	assert.True(t, ok)
+	data, err := json.Marshal(prop)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestNewReflector(t *testing.T) {
+	s := NewReflector().Reflect(user{})
+
+	assert.JSONEq(t, `{"type":"string"}`, property(t, s, "name"))
+	assert.JSONEq(t, `{"oneOf":[{"type":"integer"},{"type":"null"}]}`, property(t, s, "age"))
+	assert.JSONEq(t, `{"oneOf":[{"type":"string","format":"date-time"},{"type":"null"}]}`, property(t, s, "born"))
+	assert.JSONEq(t, `{"oneOf":[{
+		"type":"object",
+		"properties":{"city":{"type":"string"}},
+		"additionalProperties":false,
+		"required":["city"]
+	},{"type":"null"}]}`, property(t, s, "address"))
+	assert.JSONEq(t, `{"oneOf":[{"type":"string"},{"type":"null"}]}`, property(t, s, "nick"))
+	assert.Equal(t, []string{"name", "nick"}, s.Definitions["user"].Required)
+}
+
+func TestConfigure_KeepsMapper(t *testing.T) {
+	r := &jsonschema.Reflector{
+		Mapper: func(t reflect.Type) *jsonschema.Schema {
+			if t == reflect.TypeFor[time.Time]() {
+				return &jsonschema.Schema{Type: "integer"}
+			}
+			return nil
+		},
+	}
+	Configure(r)
+
+	s := r.Reflect(user{})
+	assert.JSONEq(t, `{"oneOf":[{"type":"integer"},{"type":"null"}]}`, property(t, s, "born"))
+}
@@ -0,0 +1,67 @@
+package option
+
+import (
+	"sync"
+
+	"github.com/jkratz55/gonads"
+)
+
+// Lazy is an Option computed by a Supplier the first time it is inspected, after
+// which the result is cached. Lazy is useful when determining presence is itself
+// expensive, ie probing the disk or network.
+//
+// Lazy is safe for concurrent use, the Supplier is invoked at most once even when
+// inspected from multiple goroutines. The zero value isn't usable and a Lazy
+// needs to be created with NewLazy.
+//
+// If the Supplier panics, the panic is cached like a result: it propagates to the
+// caller that invoked the Supplier and the same value is panicked with on every
+// later access, rather than the Lazy reporting None.
+type Lazy[T any] struct {
+	once     sync.Once
+	fn       gonads.Supplier[Option[T]]
+	opt      Option[T]
+	panicked bool
+	panicVal any
+}
+
+// NewLazy creates a Lazy that computes its Option using the Supplier.
+func NewLazy[T any](fn gonads.Supplier[Option[T]]) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// Option returns the Option, invoking the Supplier if it hasn't been invoked yet.
+func (l *Lazy[T]) Option() Option[T] {
+	l.once.Do(func() {
+		defer func() {
+			l.fn = nil
+			if r := recover(); r != nil {
+				l.panicked = true
+				l.panicVal = r
+			}
+		}()
+		l.opt = l.fn()
+	})
+	if l.panicked {
+		panic(l.panicVal)
+	}
+	return l.opt
+}
+
+// IsSome returns a boolean indicating if the Option is Some, invoking the Supplier
+// if it hasn't been invoked yet.
+func (l *Lazy[T]) IsSome() bool {
+	return l.Option().exists
+}
+
+// IsNone returns a boolean indicating if the Option is None, invoking the Supplier
+// if it hasn't been invoked yet.
+func (l *Lazy[T]) IsNone() bool {
+	return !l.Option().exists
+}
+
+// Get returns the value of the Option along with a boolean indicating if the
+// value is present, invoking the Supplier if it hasn't been invoked yet.
+func (l *Lazy[T]) Get() (T, bool) {
+	return l.Option().Get()
+}
@@ -0,0 +1,64 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	lazy := NewLazy(func() Option[string] {
+		calls++
+		return Some("Billy")
+	})
+	assert.Equal(t, 0, calls)
+
+	assert.True(t, lazy.IsSome())
+	assert.False(t, lazy.IsNone())
+	val, ok := lazy.Get()
+	assert.Equal(t, "Billy", val)
+	assert.True(t, ok)
+	assert.Equal(t, Some("Billy"), lazy.Option())
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazy_None(t *testing.T) {
+	lazy := NewLazy(None[int])
+	assert.True(t, lazy.IsNone())
+	assert.Equal(t, None[int](), lazy.Option())
+}
+
+func TestLazy_Panic(t *testing.T) {
+	calls := 0
+	lazy := NewLazy(func() Option[int] {
+		calls++
+		panic("boom")
+	})
+
+	assert.PanicsWithValue(t, "boom", func() { lazy.Option() })
+	assert.PanicsWithValue(t, "boom", func() { lazy.IsNone() })
+	assert.PanicsWithValue(t, "boom", func() { lazy.Get() })
+	assert.Equal(t, 1, calls)
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	var calls atomic.Int32
+	lazy := NewLazy(func() Option[int] {
+		calls.Add(1)
+		return Some(42)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, Some(42), lazy.Option())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,53 @@
+// Package logcompat lets Options and Results be logged as structured objects with
+// go.uber.org/zap and github.com/rs/zerolog, so logs show whether a value is
+// present or failed without unwrapping it at every log site.
+//
+//	logger.Info("loaded user", zap.Object("nickname", logcompat.MarshalOption(user.Nickname)))
+//	log.Info().Object("balance", logcompat.MarshalResult(balance)).Msg("loaded user")
+//
+// An Option is logged as {"present":true,"value":...} or {"present":false} and a
+// Result as {"ok":true,"value":...} or {"ok":false,"error":"..."}. The value is
+// logged using its own object or array marshaler for the logger when it
+// implements one.
+package logcompat
+
+import (
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// Option is an option.Option implementing zapcore.ObjectMarshaler and
+// zerolog.LogObjectMarshaler.
+type Option[T any] option.Option[T]
+
+// Result is a result.Result implementing zapcore.ObjectMarshaler and
+// zerolog.LogObjectMarshaler.
+type Result[T any] result.Result[T]
+
+// Options is a slice of option.Option implementing zapcore.ArrayMarshaler and
+// zerolog.LogArrayMarshaler, each Option is logged as an Option.
+type Options[T any] []option.Option[T]
+
+// Results is a slice of result.Result implementing zapcore.ArrayMarshaler and
+// zerolog.LogArrayMarshaler, each Result is logged as a Result.
+type Results[T any] []result.Result[T]
+
+// MarshalOption returns the Option as an Option that can be logged.
+func MarshalOption[T any](o option.Option[T]) Option[T] {
+	return Option[T](o)
+}
+
+// MarshalResult returns the Result as a Result that can be logged.
+func MarshalResult[T any](r result.Result[T]) Result[T] {
+	return Result[T](r)
+}
+
+// MarshalOptions returns the Options as Options that can be logged.
+func MarshalOptions[T any](opts []option.Option[T]) Options[T] {
+	return opts
+}
+
+// MarshalResults returns the Results as Results that can be logged.
+func MarshalResults[T any](results []result.Result[T]) Results[T] {
+	return results
+}
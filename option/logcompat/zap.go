@@ -0,0 +1,60 @@
+package logcompat
+
+import (
+	"go.uber.org/zap/zapcore"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface.
+func (o Option[T]) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	val, ok := option.Option[T](o).Get()
+	enc.AddBool("present", ok)
+	if !ok {
+		return nil
+	}
+	return addZapValue(enc, val)
+}
+
+// MarshalLogObject implements the zapcore.ObjectMarshaler interface.
+func (r Result[T]) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	val, err := result.Result[T](r).Get()
+	enc.AddBool("ok", err == nil)
+	if err != nil {
+		enc.AddString("error", err.Error())
+		return nil
+	}
+	return addZapValue(enc, val)
+}
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+func (opts Options[T]) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, o := range opts {
+		if err := enc.AppendObject(Option[T](o)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+func (results Results[T]) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, r := range results {
+		if err := enc.AppendObject(Result[T](r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addZapValue(enc zapcore.ObjectEncoder, val any) error {
+	switch v := val.(type) {
+	case zapcore.ObjectMarshaler:
+		return enc.AddObject("value", v)
+	case zapcore.ArrayMarshaler:
+		return enc.AddArray("value", v)
+	default:
+		return enc.AddReflected("value", v)
+	}
+}
@@ -0,0 +1,65 @@
+package logcompat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+type zapUser struct {
+	Name string
+}
+
+func (u zapUser) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("name", u.Name)
+	return nil
+}
+
+func zapFields(fields ...zapcore.Field) map[string]any {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	return enc.Fields
+}
+
+func TestZap_Option(t *testing.T) {
+	fields := zapFields(
+		zap.Object("some", MarshalOption(option.Some(42))),
+		zap.Object("none", MarshalOption(option.None[int]())),
+		zap.Object("user", MarshalOption(option.Some(zapUser{Name: "Billy"}))),
+	)
+	assert.Equal(t, map[string]any{"present": true, "value": 42}, fields["some"])
+	assert.Equal(t, map[string]any{"present": false}, fields["none"])
+	assert.Equal(t, map[string]any{"present": true, "value": map[string]any{"name": "Billy"}}, fields["user"])
+}
+
+func TestZap_Result(t *testing.T) {
+	fields := zapFields(
+		zap.Object("ok", MarshalResult(result.Ok("Billy"))),
+		zap.Object("err", MarshalResult(result.Error[string](errors.New("not found")))),
+	)
+	assert.Equal(t, map[string]any{"ok": true, "value": "Billy"}, fields["ok"])
+	assert.Equal(t, map[string]any{"ok": false, "error": "not found"}, fields["err"])
+}
+
+func TestZap_Arrays(t *testing.T) {
+	fields := zapFields(
+		zap.Array("opts", MarshalOptions([]option.Option[int]{option.Some(1), option.None[int]()})),
+		zap.Array("results", MarshalResults([]result.Result[int]{result.Ok(1), result.Error[int](errors.New("boom"))})),
+	)
+	assert.Equal(t, []any{
+		map[string]any{"present": true, "value": 1},
+		map[string]any{"present": false},
+	}, fields["opts"])
+	assert.Equal(t, []any{
+		map[string]any{"ok": true, "value": 1},
+		map[string]any{"ok": false, "error": "boom"},
+	}, fields["results"])
+}
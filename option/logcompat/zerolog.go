@@ -0,0 +1,53 @@
+package logcompat
+
+import (
+	"github.com/rs/zerolog"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (o Option[T]) MarshalZerologObject(e *zerolog.Event) {
+	val, ok := option.Option[T](o).Get()
+	e.Bool("present", ok)
+	if ok {
+		addZerologValue(e, val)
+	}
+}
+
+// MarshalZerologObject implements the zerolog.LogObjectMarshaler interface.
+func (r Result[T]) MarshalZerologObject(e *zerolog.Event) {
+	val, err := result.Result[T](r).Get()
+	e.Bool("ok", err == nil)
+	if err != nil {
+		e.Str("error", err.Error())
+		return
+	}
+	addZerologValue(e, val)
+}
+
+// MarshalZerologArray implements the zerolog.LogArrayMarshaler interface.
+func (opts Options[T]) MarshalZerologArray(a *zerolog.Array) {
+	for _, o := range opts {
+		a.Object(Option[T](o))
+	}
+}
+
+// MarshalZerologArray implements the zerolog.LogArrayMarshaler interface.
+func (results Results[T]) MarshalZerologArray(a *zerolog.Array) {
+	for _, r := range results {
+		a.Object(Result[T](r))
+	}
+}
+
+func addZerologValue(e *zerolog.Event, val any) {
+	switch v := val.(type) {
+	case zerolog.LogObjectMarshaler:
+		e.Object("value", v)
+	case zerolog.LogArrayMarshaler:
+		e.Array("value", v)
+	default:
+		e.Interface("value", v)
+	}
+}
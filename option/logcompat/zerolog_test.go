@@ -0,0 +1,62 @@
+package logcompat
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+type zerologUser struct {
+	Name string
+}
+
+func (u zerologUser) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("name", u.Name)
+}
+
+func TestZerolog_Option(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Log().
+		Object("some", MarshalOption(option.Some(42))).
+		Object("none", MarshalOption(option.None[int]())).
+		Object("user", MarshalOption(option.Some(zerologUser{Name: "Billy"}))).
+		Send()
+	assert.JSONEq(t, `{
+		"some": {"present": true, "value": 42},
+		"none": {"present": false},
+		"user": {"present": true, "value": {"name": "Billy"}}
+	}`, buf.String())
+}
+
+func TestZerolog_Result(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Log().
+		Object("ok", MarshalResult(result.Ok("Billy"))).
+		Object("err", MarshalResult(result.Error[string](errors.New("not found")))).
+		Send()
+	assert.JSONEq(t, `{
+		"ok": {"ok": true, "value": "Billy"},
+		"err": {"ok": false, "error": "not found"}
+	}`, buf.String())
+}
+
+func TestZerolog_Arrays(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	logger.Log().
+		Array("opts", MarshalOptions([]option.Option[int]{option.Some(1), option.None[int]()})).
+		Array("results", MarshalResults([]result.Result[int]{result.Ok(1), result.Error[int](errors.New("boom"))})).
+		Send()
+	assert.JSONEq(t, `{
+		"opts": [{"present": true, "value": 1}, {"present": false}],
+		"results": [{"ok": true, "value": 1}, {"ok": false, "error": "boom"}]
+	}`, buf.String())
+}
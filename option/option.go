@@ -1,15 +1,13 @@
 package option
 
 import (
-	"bytes"
-	"encoding/json"
+	"iter"
+	"os"
 	"reflect"
 
 	"github.com/jkratz55/gonads"
 )
 
-var jsonNull = []byte("null")
-
 // Option is a data type that represents a container that may or may not contain
 // a value.
 //
@@ -18,23 +16,27 @@ var jsonNull = []byte("null")
 //	Some - Contains a value
 //	None - Does not contain a value
 //
-// The zero-value isn't usable and an Option needs to be instantiated using one
-// of the four factory methods.
+// The zero value of an Option is None and is ready to use, so Options are safe in
+// structs and maps that are zero-initialized, ie by decoding libraries. An
+// Option containing a value needs to be instantiated using one of the factory
+// methods.
 //
-//		Some - For creating an Option that contains a value
-//		None - For creating an Option that is absent of a value
-//	 FromNillable - Shorthand that will create Some or None depending on the value
-//		PtrFromNillable - When an Option is needed where the value is a pointer
+//	Some - For creating an Option that contains a value
+//	None - For creating an Option that is absent of a value
+//	FromNillable - Shorthand that will create Some or None depending on the value
+//	PtrFromNillable - When an Option is needed where the value is a pointer
 //
 // Option supports JSON marshalling and unmarshalling out of the box. However, do
 // to the way it is implemented `omitempty` will have no effect and won't prevent
-// value from being encoded.
+// value from being encoded. Instead, use the `omitzero` tag option (Go 1.24+)
+// which omits the field when the Option is None.
 type Option[T any] struct {
 	val    T
 	exists bool
 }
 
-// Some creates an Option instance from a valid value.
+// Some creates an Option instance from a valid value. Some panics if val is a nil
+// interface value, use SomeNillable when the value may be nil.
 func Some[T any](val T) Option[T] {
 	// This is a protective guard to prevent misuse of the API.
 	// If someone wanted to be a wise guy they could do something like the following:
@@ -58,7 +60,28 @@ func Some[T any](val T) Option[T] {
 	}
 }
 
-// None creates an Option instance that contains no value.
+// SomeNillable creates an Option instance from a value that may be nil. Unlike
+// Some it doesn't panic, instead it returns None if val is a nil interface value
+// or a nil pointer, map, slice, channel, or func. Otherwise returns Some.
+func SomeNillable[T any](val T) Option[T] {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() {
+		return None[T]()
+	}
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		if rv.IsNil() {
+			return None[T]()
+		}
+	}
+	return Option[T]{
+		val:    val,
+		exists: true,
+	}
+}
+
+// None creates an Option instance that contains no value. None is equivalent to
+// the zero value of Option.
 func None[T any]() Option[T] {
 	return Option[T]{
 		exists: false,
@@ -85,6 +108,91 @@ func PtrFromNillable[T any](val *T) Option[*T] {
 	return Some[*T](val)
 }
 
+// NonZero creates an Option from a comparable value. If the value is the zero
+// value of T returns None, otherwise returns Some. NonZero bridges code that uses
+// "" or 0 as a sentinel for "not set".
+func NonZero[T comparable](val T) Option[T] {
+	var zero T
+	if val == zero {
+		return None[T]()
+	}
+	return Some(val)
+}
+
+// When returns Some containing val if cond is true, otherwise None.
+func When[T any](cond bool, val T) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(val)
+}
+
+// WhenFunc returns Some containing the value produced by the Supplier if cond is
+// true, otherwise None. WhenFunc is the lazy variant of When, the Supplier is
+// only invoked if cond is true.
+func WhenFunc[T any](cond bool, fn gonads.Supplier[T]) Option[T] {
+	if !cond {
+		return None[T]()
+	}
+	return Some(fn())
+}
+
+// Unless returns Some containing val if cond is false, otherwise None. Unless is
+// the inverse of When.
+func Unless[T any](cond bool, val T) Option[T] {
+	return When(!cond, val)
+}
+
+// FromMap looks up the key in the map, returning Some containing the value if the
+// key exists, otherwise None. A nil interface value stored in the map is treated
+// as None since Some can't contain nil.
+func FromMap[K comparable, V any](m map[K]V, key K) Option[V] {
+	val, ok := m[key]
+	if !ok || any(val) == nil {
+		return None[V]()
+	}
+	return Option[V]{val: val, exists: true}
+}
+
+// FromSliceIndex returns Some containing the element at index i of the slice, or
+// None if i is out of range. Like FromMap, a nil interface element is treated as
+// None.
+func FromSliceIndex[T any](s []T, i int) Option[T] {
+	if i < 0 || i >= len(s) || any(s[i]) == nil {
+		return None[T]()
+	}
+	return Option[T]{val: s[i], exists: true}
+}
+
+// First returns Some containing the first element of the slice, or None if the
+// slice is empty.
+func First[T any](s []T) Option[T] {
+	return FromSliceIndex(s, 0)
+}
+
+// Last returns Some containing the last element of the slice, or None if the
+// slice is empty.
+func Last[T any](s []T) Option[T] {
+	return FromSliceIndex(s, len(s)-1)
+}
+
+// FromLookup invokes a comma-ok lookup function with the key, returning Some
+// containing the value if the lookup found it, otherwise None.
+func FromLookup[K, V any](lookup func(K) (V, bool), key K) Option[V] {
+	val, ok := lookup(key)
+	if !ok || any(val) == nil {
+		return None[V]()
+	}
+	return Option[V]{val: val, exists: true}
+}
+
+// FromEnv returns Some containing the value of the environment variable, or None
+// if the variable isn't set. A variable that is set to an empty string is Some,
+// use Filter to treat empty values as None.
+func FromEnv(key string) Option[string] {
+	return FromLookup(os.LookupEnv, key)
+}
+
 // IsSome returns a boolean indicating if the Option is Some.
 func (o Option[T]) IsSome() bool {
 	return o.exists
@@ -95,6 +203,12 @@ func (o Option[T]) IsNone() bool {
 	return !o.exists
 }
 
+// IsZero returns a boolean indicating if the Option is None. IsZero allows None
+// fields to be omitted when encoding JSON using the `omitzero` tag option.
+func (o Option[T]) IsZero() bool {
+	return !o.exists
+}
+
 // IfSome invokes a Consumer func passing the value of the container to the
 // Consumer if the Option is Some (contains a value).
 func (o Option[T]) IfSome(fn gonads.Consumer[T]) {
@@ -103,6 +217,31 @@ func (o Option[T]) IfSome(fn gonads.Consumer[T]) {
 	}
 }
 
+// Inspect invokes a Consumer func passing the value of the container if the
+// Option is Some and returns the Option unchanged. Unlike IfSome, Inspect can be
+// used mid-chain, ie for logging or tracing.
+func (o Option[T]) Inspect(fn gonads.Consumer[T]) Option[T] {
+	if o.exists {
+		fn(o.val)
+	}
+	return o
+}
+
+// Tap is equivalent to Inspect. It is provided so TapNone has a natural
+// counterpart, ie opt.Tap(logFound).TapNone(logMissing).
+func (o Option[T]) Tap(fn gonads.Consumer[T]) Option[T] {
+	return o.Inspect(fn)
+}
+
+// TapNone invokes the provided closure if the Option is None and returns the
+// Option unchanged.
+func (o Option[T]) TapNone(fn func()) Option[T] {
+	if !o.exists {
+		fn()
+	}
+	return o
+}
+
 // IfNone invokes the provided closure if the Option container does not contain
 // a value (None).
 func (o Option[T]) IfNone(fn func()) {
@@ -124,6 +263,64 @@ func (o Option[T]) Filter(fn gonads.Predicate[T]) Option[T] {
 	return None[T]()
 }
 
+// FilterNot is the inverse of Filter. If the Option is Some and the predicate
+// returns false, returns an Option with the value. Otherwise, returns None.
+func (o Option[T]) FilterNot(fn gonads.Predicate[T]) Option[T] {
+	if !o.exists || fn(o.val) {
+		return None[T]()
+	}
+	return o
+}
+
+// Or returns the Option if it is Some, otherwise returns other.
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	if o.exists {
+		return o
+	}
+	return other
+}
+
+// OrElse returns the Option if it is Some, otherwise invokes the provided closure
+// and returns its result. OrElse is the lazy variant of Or and is preferred when
+// computing the fallback is expensive.
+func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
+	if o.exists {
+		return o
+	}
+	return fn()
+}
+
+// And returns None if the Option is None, otherwise returns other.
+func (o Option[T]) And(other Option[T]) Option[T] {
+	if !o.exists {
+		return None[T]()
+	}
+	return other
+}
+
+// AndThen returns None if the Option is None, otherwise invokes the provided
+// closure with the value and returns its result. AndThen is similar to FlatMap
+// but can only produce an Option of the same type, allowing it to be chained.
+func (o Option[T]) AndThen(fn func(T) Option[T]) Option[T] {
+	if !o.exists {
+		return None[T]()
+	}
+	return fn(o.val)
+}
+
+// Xor returns Some if exactly one of the Option and other is Some, otherwise
+// returns None.
+func (o Option[T]) Xor(other Option[T]) Option[T] {
+	switch {
+	case o.exists && !other.exists:
+		return o
+	case !o.exists && other.exists:
+		return other
+	default:
+		return None[T]()
+	}
+}
+
 // Get returns the value of the Option container along with a boolean indicating
 // if the value is present.
 //
@@ -176,33 +373,100 @@ func (o Option[T]) Expect(msg string) T {
 	return o.val
 }
 
-// MarshalJSON marshals the Option type to JSON representation.
-func (o Option[T]) MarshalJSON() ([]byte, error) {
+// ToSlice returns a slice containing the value if the Option is Some, otherwise
+// returns an empty slice.
+func (o Option[T]) ToSlice() []T {
+	if !o.exists {
+		return []T{}
+	}
+	return []T{o.val}
+}
+
+// All returns an iterator that yields the value if the Option is Some and nothing
+// if it is None, allowing an Option to be used with range-over-func.
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.exists {
+			yield(o.val)
+		}
+	}
+}
+
+// Take moves the value out of the Option, leaving None in its place, and returns
+// the Option as it was before.
+func (o *Option[T]) Take() Option[T] {
+	prev := *o
+	*o = None[T]()
+	return prev
+}
+
+// Replace sets the Option to Some containing val and returns the Option as it was
+// before. Like Some, Replace panics if val is a nil interface value.
+func (o *Option[T]) Replace(val T) Option[T] {
+	prev := *o
+	*o = Some(val)
+	return prev
+}
+
+// Insert sets the Option to Some containing val, discarding any previous value,
+// and returns a pointer to the contained value. Like Some, Insert panics if val
+// is a nil interface value.
+func (o *Option[T]) Insert(val T) *T {
+	*o = Some(val)
+	return &o.val
+}
+
+// GetOrInsert returns a pointer to the value of the Option if it is Some.
+// Otherwise, sets the Option to Some containing val and returns a pointer to it.
+func (o *Option[T]) GetOrInsert(val T) *T {
 	if !o.exists {
-		return json.Marshal(nil)
+		*o = Some(val)
 	}
+	return &o.val
+}
 
-	b, err := json.Marshal(o.val)
-	if err != nil {
-		return nil, err
+// GetOrInsertWith returns a pointer to the value of the Option if it is Some.
+// Otherwise, invokes the Supplier, sets the Option to Some containing the supplied
+// value and returns a pointer to it. GetOrInsertWith is the lazy variant of
+// GetOrInsert and is useful for lazily initialized fields.
+func (o *Option[T]) GetOrInsertWith(fn gonads.Supplier[T]) *T {
+	if !o.exists {
+		*o = Some(fn())
 	}
-	return b, nil
+	return &o.val
+}
+
+// MarshalJSON marshals the Option type to JSON representation.
+//
+// By default None is encoded as null and Some is encoded as the value itself.
+// The representation can be changed process-wide using SetEncoding.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(o, jsonEncoding())
 }
 
 // UnmarshalJSON unmarshalls JSON representation of Option to the Option type.
+//
+// The expected representation is determined by the process-wide Encoding set
+// using SetEncoding.
 func (o *Option[T]) UnmarshalJSON(data []byte) error {
-	if len(data) <= 0 || bytes.Equal(data, jsonNull) {
-		*o = None[T]()
-		return nil
-	}
+	return unmarshalJSON(o, data, jsonEncoding())
+}
 
-	var v T
-	err := json.Unmarshal(data, &v)
-	if err != nil {
-		return err
+// Contains returns a boolean indicating if the Option is Some and contains a value
+// equal to val.
+func Contains[T comparable](opt Option[T], val T) bool {
+	return opt.exists && opt.val == val
+}
+
+// Clone returns a copy of the Option. If the value implements gonads.Cloner it is
+// deep copied using its Clone method, otherwise the value is copied by
+// assignment, which is a shallow copy for types containing pointers, slices, or
+// maps.
+func Clone[T any](opt Option[T]) Option[T] {
+	if !opt.exists {
+		return None[T]()
 	}
-	*o = Some(v)
-	return nil
+	return Some(gonads.Clone(opt.val))
 }
 
 // Map converts an Option[T] -> Option[R] by invoking the mapper function. If
@@ -223,6 +487,31 @@ func MapOr[T, R any](opt Option[T], fallback R, fn gonads.Function[T, R]) R {
 	return fn(opt.val)
 }
 
+// MapOrElse converts an Option[T] -> R by invoking the mapper function. If the
+// given option is None, the fallback function is invoked and its result returned.
+// MapOrElse is the lazy variant of MapOr and is preferred when computing the
+// fallback is expensive.
+func MapOrElse[T, R any](opt Option[T], fallback gonads.Supplier[R], fn gonads.Function[T, R]) R {
+	if !opt.exists {
+		return fallback()
+	}
+	return fn(opt.val)
+}
+
+// FilterMap filters and converts an Option[T] -> Option[R] in one pass using a
+// comma-ok mapper function. If the Option is None or the mapper returns false,
+// then None is returned.
+func FilterMap[T, R any](opt Option[T], fn func(T) (R, bool)) Option[R] {
+	if !opt.exists {
+		return None[R]()
+	}
+	val, ok := fn(opt.val)
+	if !ok {
+		return None[R]()
+	}
+	return Some(val)
+}
+
 // FlatMap converts an Option[T] -> Option[R] by invoking the mapper function. FlatMap
 // differs from Map in the mapper function returns an Option[R] instead of a value. If
 // the given Option is None, then None is returned.
@@ -242,3 +531,117 @@ func FlatMapOr[T, R any](opt Option[T], fallback R, fn func(T) Option[R]) Option
 	}
 	return fn(opt.val)
 }
+
+// Map2 converts two Options into an Option[R] by invoking the mapper function with
+// both values. If either Option is None, then None is returned.
+func Map2[A, B, R any](a Option[A], b Option[B], fn func(A, B) R) Option[R] {
+	if !a.exists || !b.exists {
+		return None[R]()
+	}
+	return Some(fn(a.val, b.val))
+}
+
+// Map3 converts three Options into an Option[R] by invoking the mapper function
+// with all three values. If any Option is None, then None is returned.
+func Map3[A, B, C, R any](a Option[A], b Option[B], c Option[C], fn func(A, B, C) R) Option[R] {
+	if !a.exists || !b.exists || !c.exists {
+		return None[R]()
+	}
+	return Some(fn(a.val, b.val, c.val))
+}
+
+// Apply invokes the function contained in fnOpt with the value contained in
+// valOpt. If either Option is None, then None is returned.
+func Apply[T, R any](fnOpt Option[func(T) R], valOpt Option[T]) Option[R] {
+	if !fnOpt.exists || !valOpt.exists {
+		return None[R]()
+	}
+	return Some(fnOpt.val(valOpt.val))
+}
+
+// Zip combines two Options into an Option of a Tuple. If both Options are Some,
+// returns Some(Tuple) containing both values, otherwise returns None.
+func Zip[A, B any](a Option[A], b Option[B]) Option[gonads.Tuple[A, B]] {
+	if !a.exists || !b.exists {
+		return None[gonads.Tuple[A, B]]()
+	}
+	return Some(gonads.Tuple[A, B]{First: a.val, Second: b.val})
+}
+
+// Unzip splits an Option of a Tuple into two Options. If the Option is Some both
+// returned Options are Some, otherwise both are None.
+func Unzip[A, B any](opt Option[gonads.Tuple[A, B]]) (Option[A], Option[B]) {
+	if !opt.exists {
+		return None[A](), None[B]()
+	}
+	return Some(opt.val.First), Some(opt.val.Second)
+}
+
+// FirstSome returns the first Option that is Some, or None if none of them are.
+// FirstSome is analogous to SQL COALESCE and is useful for layered resolution,
+// ie a flag, then an environment variable, then a default.
+func FirstSome[T any](opts ...Option[T]) Option[T] {
+	for _, opt := range opts {
+		if opt.exists {
+			return opt
+		}
+	}
+	return None[T]()
+}
+
+// Collect converts a slice of Options into an Option of a slice. If every Option
+// is Some, returns Some containing all the values in order, otherwise returns
+// None.
+func Collect[T any](opts []Option[T]) Option[[]T] {
+	vals := make([]T, 0, len(opts))
+	for _, opt := range opts {
+		if !opt.exists {
+			return None[[]T]()
+		}
+		vals = append(vals, opt.val)
+	}
+	return Some(vals)
+}
+
+// Traverse maps every item using fn and collects the values into an Option of a
+// slice. If fn returns None for any item, Traverse stops and returns None.
+func Traverse[T, R any](items []T, fn func(T) Option[R]) Option[[]R] {
+	vals := make([]R, 0, len(items))
+	for _, item := range items {
+		opt := fn(item)
+		if !opt.exists {
+			return None[[]R]()
+		}
+		vals = append(vals, opt.val)
+	}
+	return Some(vals)
+}
+
+// Reduce folds the values of the Options that are Some in order using combine,
+// skipping the Options that are None. Returns None only if every Option is None,
+// which makes Reduce useful for merging partial configs or sparse measurements.
+func Reduce[T any](opts []Option[T], combine func(T, T) T) Option[T] {
+	acc := None[T]()
+	for _, opt := range opts {
+		switch {
+		case !opt.exists:
+		case !acc.exists:
+			acc = opt
+		default:
+			acc.val = combine(acc.val, opt.val)
+		}
+	}
+	return acc
+}
+
+// Values returns the values of the Options that are Some in order, dropping the
+// Options that are None.
+func Values[T any](opts []Option[T]) []T {
+	vals := make([]T, 0, len(opts))
+	for _, opt := range opts {
+		if opt.exists {
+			vals = append(vals, opt.val)
+		}
+	}
+	return vals
+}
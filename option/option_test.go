@@ -3,6 +3,7 @@ package option
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -50,6 +51,24 @@ func TestSome(t *testing.T) {
 	assert.True(t, opt2.exists)
 }
 
+func TestSomeNillable(t *testing.T) {
+	var ptr *int
+	var m map[string]int
+	var s []int
+	var fn func()
+
+	assert.True(t, SomeNillable[error](nil).IsNone())
+	assert.True(t, SomeNillable(ptr).IsNone())
+	assert.True(t, SomeNillable(m).IsNone())
+	assert.True(t, SomeNillable(s).IsNone())
+	assert.True(t, SomeNillable(fn).IsNone())
+
+	assert.Equal(t, Some(0), SomeNillable(0))
+	assert.Equal(t, Some(""), SomeNillable(""))
+	assert.Equal(t, Some([]int{}), SomeNillable([]int{}))
+	assert.True(t, SomeNillable[error](fmt.Errorf("boom")).IsSome())
+}
+
 func TestNone(t *testing.T) {
 	var opt Option[string]
 	assert.NotPanics(t, func() {
@@ -58,6 +77,25 @@ func TestNone(t *testing.T) {
 	assert.False(t, opt.exists)
 }
 
+func TestOption_ZeroValue(t *testing.T) {
+	var opt Option[string]
+	assert.Equal(t, None[string](), opt)
+	assert.True(t, opt.IsNone())
+	assert.Equal(t, "default", opt.UnwrapOrDefault("default"))
+
+	data, err := json.Marshal(opt)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	m := map[string]Option[int]{}
+	assert.True(t, m["missing"].IsNone())
+
+	var s struct {
+		Name Option[string]
+	}
+	assert.True(t, s.Name.IsNone())
+}
+
 func TestFromNillable(t *testing.T) {
 	var p *person
 	opt := FromNillable(p)
@@ -80,6 +118,84 @@ func TestPtrFromNillable(t *testing.T) {
 	assert.Equal(t, p, opt.val)
 }
 
+func TestNonZero(t *testing.T) {
+	assert.Equal(t, Some("Billy"), NonZero("Billy"))
+	assert.Equal(t, None[string](), NonZero(""))
+	assert.Equal(t, Some(42), NonZero(42))
+	assert.Equal(t, None[int](), NonZero(0))
+	assert.Equal(t, None[error](), NonZero[error](nil))
+}
+
+func TestWhen(t *testing.T) {
+	assert.Equal(t, Some("admin"), When(true, "admin"))
+	assert.Equal(t, None[string](), When(false, "admin"))
+}
+
+func TestWhenFunc(t *testing.T) {
+	called := false
+	supplier := func() string {
+		called = true
+		return "admin"
+	}
+	assert.Equal(t, None[string](), WhenFunc(false, supplier))
+	assert.False(t, called)
+	assert.Equal(t, Some("admin"), WhenFunc(true, supplier))
+	assert.True(t, called)
+}
+
+func TestUnless(t *testing.T) {
+	assert.Equal(t, None[string](), Unless(true, "guest"))
+	assert.Equal(t, Some("guest"), Unless(false, "guest"))
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"Billy": 42, "Bob": 0}
+	assert.Equal(t, Some(42), FromMap(m, "Billy"))
+	assert.Equal(t, Some(0), FromMap(m, "Bob"))
+	assert.Equal(t, None[int](), FromMap(m, "Jim"))
+	assert.Equal(t, None[int](), FromMap[string, int](nil, "Billy"))
+	assert.Equal(t, None[error](), FromMap(map[string]error{"Billy": nil}, "Billy"))
+}
+
+func TestFromSliceIndex(t *testing.T) {
+	s := []string{"Billy", "Bob"}
+	assert.Equal(t, Some("Billy"), FromSliceIndex(s, 0))
+	assert.Equal(t, Some("Bob"), FromSliceIndex(s, 1))
+	assert.Equal(t, None[string](), FromSliceIndex(s, 2))
+	assert.Equal(t, None[string](), FromSliceIndex(s, -1))
+	assert.Equal(t, None[error](), FromSliceIndex([]error{nil}, 0))
+}
+
+func TestFirst(t *testing.T) {
+	assert.Equal(t, Some(1), First([]int{1, 2, 3}))
+	assert.Equal(t, None[int](), First([]int{}))
+}
+
+func TestLast(t *testing.T) {
+	assert.Equal(t, Some(3), Last([]int{1, 2, 3}))
+	assert.Equal(t, None[int](), Last[int](nil))
+}
+
+func TestFromLookup(t *testing.T) {
+	m := map[string]int{"Billy": 42}
+	lookup := func(key string) (int, bool) {
+		val, ok := m[key]
+		return val, ok
+	}
+	assert.Equal(t, Some(42), FromLookup(lookup, "Billy"))
+	assert.Equal(t, None[int](), FromLookup(lookup, "Bob"))
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("GONADS_TEST_SET", "value")
+	t.Setenv("GONADS_TEST_EMPTY", "")
+
+	assert.Equal(t, Some("value"), FromEnv("GONADS_TEST_SET"))
+	assert.Equal(t, Some(""), FromEnv("GONADS_TEST_EMPTY"))
+	assert.Equal(t, None[string](), FromEnv("GONADS_TEST_MISSING"))
+	assert.Equal(t, "default", FromEnv("GONADS_TEST_MISSING").UnwrapOrDefault("default"))
+}
+
 func TestOption_IsSome(t *testing.T) {
 	opt := Some("Billy Bob")
 	assert.True(t, opt.exists)
@@ -109,6 +225,41 @@ func TestOption_IfSome(t *testing.T) {
 	assert.False(t, called)
 }
 
+func TestOption_Inspect(t *testing.T) {
+	var seen []string
+	opt := Some("Billy Bob").
+		Inspect(func(val string) {
+			seen = append(seen, val)
+		}).
+		Filter(func(val string) bool { return len(val) > 3 })
+	assert.Equal(t, Some("Billy Bob"), opt)
+	assert.Equal(t, []string{"Billy Bob"}, seen)
+
+	seen = nil
+	opt = None[string]().Inspect(func(val string) {
+		seen = append(seen, val)
+	})
+	assert.Equal(t, None[string](), opt)
+	assert.Nil(t, seen)
+}
+
+func TestOption_Tap(t *testing.T) {
+	var found []string
+	missing := 0
+	logFound := func(val string) { found = append(found, val) }
+	logMissing := func() { missing++ }
+
+	val := Some("Billy").Tap(logFound).TapNone(logMissing).UnwrapOrDefault("default")
+	assert.Equal(t, "Billy", val)
+	assert.Equal(t, []string{"Billy"}, found)
+	assert.Equal(t, 0, missing)
+
+	val = None[string]().Tap(logFound).TapNone(logMissing).UnwrapOrDefault("default")
+	assert.Equal(t, "default", val)
+	assert.Equal(t, []string{"Billy"}, found)
+	assert.Equal(t, 1, missing)
+}
+
 func TestOption_IfNone(t *testing.T) {
 	called := false
 	opt := None[string]()
@@ -165,6 +316,56 @@ func TestOption_Filter(t *testing.T) {
 	}
 }
 
+func TestOption_FilterNot(t *testing.T) {
+	isEmpty := func(val string) bool { return val == "" }
+	assert.Equal(t, Some("Billy"), Some("Billy").FilterNot(isEmpty))
+	assert.Equal(t, None[string](), Some("").FilterNot(isEmpty))
+	assert.Equal(t, None[string](), None[string]().FilterNot(isEmpty))
+}
+
+func TestOption_Or(t *testing.T) {
+	assert.Equal(t, Some("Billy"), Some("Billy").Or(Some("Bob")))
+	assert.Equal(t, Some("Bob"), None[string]().Or(Some("Bob")))
+	assert.Equal(t, None[string](), None[string]().Or(None[string]()))
+}
+
+func TestOption_OrElse(t *testing.T) {
+	called := false
+	fallback := func() Option[string] {
+		called = true
+		return Some("Bob")
+	}
+	assert.Equal(t, Some("Billy"), Some("Billy").OrElse(fallback))
+	assert.False(t, called)
+	assert.Equal(t, Some("Bob"), None[string]().OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestOption_And(t *testing.T) {
+	assert.Equal(t, Some("Bob"), Some("Billy").And(Some("Bob")))
+	assert.Equal(t, None[string](), Some("Billy").And(None[string]()))
+	assert.Equal(t, None[string](), None[string]().And(Some("Bob")))
+}
+
+func TestOption_AndThen(t *testing.T) {
+	half := func(i int) Option[int] {
+		if i%2 != 0 {
+			return None[int]()
+		}
+		return Some(i / 2)
+	}
+	assert.Equal(t, Some(2), Some(8).AndThen(half).AndThen(half))
+	assert.Equal(t, None[int](), Some(6).AndThen(half).AndThen(half))
+	assert.Equal(t, None[int](), None[int]().AndThen(half))
+}
+
+func TestOption_Xor(t *testing.T) {
+	assert.Equal(t, Some("Billy"), Some("Billy").Xor(None[string]()))
+	assert.Equal(t, Some("Bob"), None[string]().Xor(Some("Bob")))
+	assert.Equal(t, None[string](), Some("Billy").Xor(Some("Bob")))
+	assert.Equal(t, None[string](), None[string]().Xor(None[string]()))
+}
+
 func TestOption_Get(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -304,6 +505,75 @@ func TestOption_Expect(t *testing.T) {
 	assert.Equal(t, "Billy Bob", opt.Expect("oppps missing value"))
 }
 
+func TestOption_ToSlice(t *testing.T) {
+	assert.Equal(t, []string{"Billy"}, Some("Billy").ToSlice())
+	assert.Equal(t, []string{}, None[string]().ToSlice())
+}
+
+func TestOption_All(t *testing.T) {
+	var vals []string
+	for val := range Some("Billy").All() {
+		vals = append(vals, val)
+	}
+	assert.Equal(t, []string{"Billy"}, vals)
+
+	vals = nil
+	for val := range None[string]().All() {
+		vals = append(vals, val)
+	}
+	assert.Nil(t, vals)
+}
+
+func TestOption_Take(t *testing.T) {
+	opt := Some("Billy")
+	assert.Equal(t, Some("Billy"), opt.Take())
+	assert.Equal(t, None[string](), opt)
+	assert.Equal(t, None[string](), opt.Take())
+}
+
+func TestOption_Replace(t *testing.T) {
+	opt := None[string]()
+	assert.Equal(t, None[string](), opt.Replace("Billy"))
+	assert.Equal(t, Some("Billy"), opt.Replace("Bob"))
+	assert.Equal(t, Some("Bob"), opt)
+
+	var errOpt Option[error]
+	assert.Panics(t, func() { errOpt.Replace(nil) })
+}
+
+func TestOption_Insert(t *testing.T) {
+	opt := Some(1)
+	ptr := opt.Insert(2)
+	assert.Equal(t, 2, *ptr)
+
+	*ptr = 3
+	assert.Equal(t, Some(3), opt)
+}
+
+func TestOption_GetOrInsert(t *testing.T) {
+	opt := None[int]()
+	assert.Equal(t, 1, *opt.GetOrInsert(1))
+	assert.Equal(t, 1, *opt.GetOrInsert(2))
+	assert.Equal(t, Some(1), opt)
+}
+
+func TestOption_GetOrInsertWith(t *testing.T) {
+	calls := 0
+	supplier := func() []string {
+		calls++
+		return []string{}
+	}
+
+	var opt Option[[]string]
+	ptr := opt.GetOrInsertWith(supplier)
+	*ptr = append(*ptr, "Billy")
+	ptr = opt.GetOrInsertWith(supplier)
+	*ptr = append(*ptr, "Bob")
+
+	assert.Equal(t, Some([]string{"Billy", "Bob"}), opt)
+	assert.Equal(t, 1, calls)
+}
+
 func TestOption_MarshalJSON(t *testing.T) {
 
 	p := person{
@@ -317,6 +587,19 @@ func TestOption_MarshalJSON(t *testing.T) {
 	assert.Equal(t, []byte("{\"firstName\":\"Billy\",\"middleName\":null,\"lastName\":\"Bob\",\"gender\":\"MALE\"}"), data)
 }
 
+func TestOption_IsZero(t *testing.T) {
+	assert.True(t, None[string]().IsZero())
+	assert.False(t, Some("").IsZero())
+
+	type update struct {
+		Name  Option[string] `json:"name,omitzero"`
+		Email Option[string] `json:"email,omitzero"`
+	}
+	data, err := json.Marshal(update{Name: Some("Billy"), Email: None[string]()})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"Billy"}`, string(data))
+}
+
 func TestOption_UnmarshalJSON(t *testing.T) {
 
 	data := []byte("{\"firstName\":\"Billy\",\"middleName\":null,\"lastName\":\"Bob\",\"gender\":\"MALE\"}")
@@ -331,3 +614,151 @@ func TestOption_UnmarshalJSON(t *testing.T) {
 		Gender:     Some("MALE"),
 	}, p)
 }
+
+func TestFilterMap(t *testing.T) {
+	lookup := map[string]int{"Billy": 42}
+	find := func(name string) (int, bool) {
+		val, ok := lookup[name]
+		return val, ok
+	}
+	assert.Equal(t, Some(42), FilterMap(Some("Billy"), find))
+	assert.Equal(t, None[int](), FilterMap(Some("Bob"), find))
+	assert.Equal(t, None[int](), FilterMap(None[string](), find))
+}
+
+func TestMap2(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	assert.Equal(t, Some(3), Map2(Some(1), Some(2), add))
+	assert.Equal(t, None[int](), Map2(None[int](), Some(2), add))
+	assert.Equal(t, None[int](), Map2(Some(1), None[int](), add))
+}
+
+func TestMap3(t *testing.T) {
+	format := func(name string, age int, admin bool) string {
+		return fmt.Sprintf("%s:%d:%t", name, age, admin)
+	}
+	assert.Equal(t, Some("Billy:42:true"), Map3(Some("Billy"), Some(42), Some(true), format))
+	assert.Equal(t, None[string](), Map3(Some("Billy"), Some(42), None[bool](), format))
+}
+
+func TestApply(t *testing.T) {
+	double := Some(func(val int) int { return val * 2 })
+	assert.Equal(t, Some(4), Apply(double, Some(2)))
+	assert.Equal(t, None[int](), Apply(double, None[int]()))
+	assert.Equal(t, None[int](), Apply(None[func(int) int](), Some(2)))
+}
+
+func TestZip(t *testing.T) {
+	assert.Equal(t, Some(gonads.Tuple[string, int]{First: "Billy", Second: 30}), Zip(Some("Billy"), Some(30)))
+	assert.Equal(t, None[gonads.Tuple[string, int]](), Zip(None[string](), Some(30)))
+	assert.Equal(t, None[gonads.Tuple[string, int]](), Zip(Some("Billy"), None[int]()))
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Some(gonads.Tuple[string, int]{First: "Billy", Second: 30}))
+	assert.Equal(t, Some("Billy"), a)
+	assert.Equal(t, Some(30), b)
+
+	a, b = Unzip(None[gonads.Tuple[string, int]]())
+	assert.True(t, a.IsNone())
+	assert.True(t, b.IsNone())
+}
+
+func TestContains(t *testing.T) {
+	assert.True(t, Contains(Some("Billy"), "Billy"))
+	assert.False(t, Contains(Some("Billy"), "Bob"))
+	assert.False(t, Contains(None[string](), "Billy"))
+	assert.False(t, Contains(None[string](), ""))
+}
+
+type roles []string
+
+func (r roles) Clone() roles {
+	return append(roles{}, r...)
+}
+
+func TestClone(t *testing.T) {
+	original := Some(roles{"admin", "user"})
+	clone := Clone(original)
+	clone.Unwrap()[0] = "guest"
+	assert.Equal(t, Some(roles{"admin", "user"}), original)
+
+	assert.Equal(t, Some(42), Clone(Some(42)))
+	assert.Equal(t, None[roles](), Clone(None[roles]()))
+}
+
+func TestMapOrElse(t *testing.T) {
+	called := false
+	fallback := func() int {
+		called = true
+		return -1
+	}
+	length := func(s string) int {
+		return len(s)
+	}
+
+	assert.Equal(t, 5, MapOrElse(Some("Billy"), fallback, length))
+	assert.False(t, called)
+	assert.Equal(t, -1, MapOrElse(None[string](), fallback, length))
+	assert.True(t, called)
+}
+
+func TestFirstSome(t *testing.T) {
+	assert.Equal(t, Some("env"), FirstSome(None[string](), Some("env"), Some("default")))
+	assert.Equal(t, None[string](), FirstSome(None[string](), None[string]()))
+	assert.Equal(t, None[string](), FirstSome[string]())
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option[int]
+		expected Option[[]int]
+	}{
+		{name: "All Some", opts: []Option[int]{Some(1), Some(2)}, expected: Some([]int{1, 2})},
+		{name: "Contains None", opts: []Option[int]{Some(1), None[int]()}, expected: None[[]int]()},
+		{name: "Empty", opts: nil, expected: Some([]int{})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Collect(test.opts))
+		})
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	calls := 0
+	parse := func(s string) Option[int] {
+		calls++
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+		return Some(n)
+	}
+
+	assert.Equal(t, Some([]int{1, 2, 3}), Traverse([]string{"1", "2", "3"}, parse))
+
+	calls = 0
+	assert.Equal(t, None[[]int](), Traverse([]string{"1", "x", "3"}, parse))
+	assert.Equal(t, 2, calls)
+
+	assert.Equal(t, Some([]int{}), Traverse(nil, parse))
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	assert.Equal(t, Some(4), Reduce([]Option[int]{Some(1), None[int](), Some(3)}, sum))
+	assert.Equal(t, Some(2), Reduce([]Option[int]{None[int](), Some(2)}, sum))
+	assert.Equal(t, None[int](), Reduce([]Option[int]{None[int](), None[int]()}, sum))
+	assert.Equal(t, None[int](), Reduce(nil, sum))
+
+	concat := func(a, b string) string { return a + b }
+	assert.Equal(t, Some("abc"), Reduce([]Option[string]{Some("a"), Some("b"), None[string](), Some("c")}, concat))
+}
+
+func TestValues(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, Values([]Option[int]{Some(1), None[int](), Some(3)}))
+	assert.Equal(t, []int{}, Values([]Option[int]{None[int]()}))
+}
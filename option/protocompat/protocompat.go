@@ -0,0 +1,159 @@
+// Package protocompat converts between Options and the protobuf well-known
+// wrapper types, timestamps, durations, and proto3 optional fields, so gRPC
+// message builders can consume Options directly.
+//
+// A nil wrapper converts to None and None converts to a nil wrapper, matching how
+// protobuf represents an absent field.
+package protocompat
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+// ToStringValue converts an Option into a *wrapperspb.StringValue.
+func ToStringValue(o option.Option[string]) *wrapperspb.StringValue {
+	return toWrapper(o, wrapperspb.String)
+}
+
+// FromStringValue converts a *wrapperspb.StringValue into an Option.
+func FromStringValue(v *wrapperspb.StringValue) option.Option[string] {
+	return fromWrapper(v, (*wrapperspb.StringValue).GetValue)
+}
+
+// ToBytesValue converts an Option into a *wrapperspb.BytesValue.
+func ToBytesValue(o option.Option[[]byte]) *wrapperspb.BytesValue {
+	return toWrapper(o, wrapperspb.Bytes)
+}
+
+// FromBytesValue converts a *wrapperspb.BytesValue into an Option.
+func FromBytesValue(v *wrapperspb.BytesValue) option.Option[[]byte] {
+	return fromWrapper(v, (*wrapperspb.BytesValue).GetValue)
+}
+
+// ToBoolValue converts an Option into a *wrapperspb.BoolValue.
+func ToBoolValue(o option.Option[bool]) *wrapperspb.BoolValue {
+	return toWrapper(o, wrapperspb.Bool)
+}
+
+// FromBoolValue converts a *wrapperspb.BoolValue into an Option.
+func FromBoolValue(v *wrapperspb.BoolValue) option.Option[bool] {
+	return fromWrapper(v, (*wrapperspb.BoolValue).GetValue)
+}
+
+// ToInt32Value converts an Option into a *wrapperspb.Int32Value.
+func ToInt32Value(o option.Option[int32]) *wrapperspb.Int32Value {
+	return toWrapper(o, wrapperspb.Int32)
+}
+
+// FromInt32Value converts a *wrapperspb.Int32Value into an Option.
+func FromInt32Value(v *wrapperspb.Int32Value) option.Option[int32] {
+	return fromWrapper(v, (*wrapperspb.Int32Value).GetValue)
+}
+
+// ToInt64Value converts an Option into a *wrapperspb.Int64Value.
+func ToInt64Value(o option.Option[int64]) *wrapperspb.Int64Value {
+	return toWrapper(o, wrapperspb.Int64)
+}
+
+// FromInt64Value converts a *wrapperspb.Int64Value into an Option.
+func FromInt64Value(v *wrapperspb.Int64Value) option.Option[int64] {
+	return fromWrapper(v, (*wrapperspb.Int64Value).GetValue)
+}
+
+// ToUInt32Value converts an Option into a *wrapperspb.UInt32Value.
+func ToUInt32Value(o option.Option[uint32]) *wrapperspb.UInt32Value {
+	return toWrapper(o, wrapperspb.UInt32)
+}
+
+// FromUInt32Value converts a *wrapperspb.UInt32Value into an Option.
+func FromUInt32Value(v *wrapperspb.UInt32Value) option.Option[uint32] {
+	return fromWrapper(v, (*wrapperspb.UInt32Value).GetValue)
+}
+
+// ToUInt64Value converts an Option into a *wrapperspb.UInt64Value.
+func ToUInt64Value(o option.Option[uint64]) *wrapperspb.UInt64Value {
+	return toWrapper(o, wrapperspb.UInt64)
+}
+
+// FromUInt64Value converts a *wrapperspb.UInt64Value into an Option.
+func FromUInt64Value(v *wrapperspb.UInt64Value) option.Option[uint64] {
+	return fromWrapper(v, (*wrapperspb.UInt64Value).GetValue)
+}
+
+// ToFloatValue converts an Option into a *wrapperspb.FloatValue.
+func ToFloatValue(o option.Option[float32]) *wrapperspb.FloatValue {
+	return toWrapper(o, wrapperspb.Float)
+}
+
+// FromFloatValue converts a *wrapperspb.FloatValue into an Option.
+func FromFloatValue(v *wrapperspb.FloatValue) option.Option[float32] {
+	return fromWrapper(v, (*wrapperspb.FloatValue).GetValue)
+}
+
+// ToDoubleValue converts an Option into a *wrapperspb.DoubleValue.
+func ToDoubleValue(o option.Option[float64]) *wrapperspb.DoubleValue {
+	return toWrapper(o, wrapperspb.Double)
+}
+
+// FromDoubleValue converts a *wrapperspb.DoubleValue into an Option.
+func FromDoubleValue(v *wrapperspb.DoubleValue) option.Option[float64] {
+	return fromWrapper(v, (*wrapperspb.DoubleValue).GetValue)
+}
+
+// ToTimestamp converts an Option into a *timestamppb.Timestamp.
+func ToTimestamp(o option.Option[time.Time]) *timestamppb.Timestamp {
+	return toWrapper(o, timestamppb.New)
+}
+
+// FromTimestamp converts a *timestamppb.Timestamp into an Option. The time is in
+// UTC, as returned by Timestamp.AsTime.
+func FromTimestamp(v *timestamppb.Timestamp) option.Option[time.Time] {
+	return fromWrapper(v, (*timestamppb.Timestamp).AsTime)
+}
+
+// ToDuration converts an Option into a *durationpb.Duration.
+func ToDuration(o option.Option[time.Duration]) *durationpb.Duration {
+	return toWrapper(o, durationpb.New)
+}
+
+// FromDuration converts a *durationpb.Duration into an Option.
+func FromDuration(v *durationpb.Duration) option.Option[time.Duration] {
+	return fromWrapper(v, (*durationpb.Duration).AsDuration)
+}
+
+// ToOptional converts an Option into a pointer as used by the generated code for
+// proto3 optional fields. None is converted to nil.
+func ToOptional[T any](o option.Option[T]) *T {
+	val, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return &val
+}
+
+// FromOptional converts a proto3 optional field into an Option. A nil pointer is
+// converted to None.
+func FromOptional[T any](v *T) option.Option[T] {
+	return option.FromNillable(v)
+}
+
+func toWrapper[T any, W any](o option.Option[T], wrap func(T) *W) *W {
+	val, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return wrap(val)
+}
+
+func fromWrapper[T any, W any](v *W, get func(*W) T) option.Option[T] {
+	if v == nil {
+		return option.None[T]()
+	}
+	return option.Some(get(v))
+}
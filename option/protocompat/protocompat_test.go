@@ -0,0 +1,53 @@
+package protocompat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestWrappers(t *testing.T) {
+	assert.True(t, proto.Equal(wrapperspb.String("Billy"), ToStringValue(option.Some("Billy"))))
+	assert.Nil(t, ToStringValue(option.None[string]()))
+	assert.Equal(t, option.Some("Billy"), FromStringValue(wrapperspb.String("Billy")))
+	assert.Equal(t, option.None[string](), FromStringValue(nil))
+
+	assert.Equal(t, option.Some([]byte("raw")), FromBytesValue(ToBytesValue(option.Some([]byte("raw")))))
+	assert.Equal(t, option.Some(false), FromBoolValue(ToBoolValue(option.Some(false))))
+	assert.Equal(t, option.Some(int32(-1)), FromInt32Value(ToInt32Value(option.Some(int32(-1)))))
+	assert.Equal(t, option.Some(int64(42)), FromInt64Value(ToInt64Value(option.Some(int64(42)))))
+	assert.Equal(t, option.Some(uint32(1)), FromUInt32Value(ToUInt32Value(option.Some(uint32(1)))))
+	assert.Equal(t, option.Some(uint64(2)), FromUInt64Value(ToUInt64Value(option.Some(uint64(2)))))
+	assert.Equal(t, option.Some(float32(1.5)), FromFloatValue(ToFloatValue(option.Some(float32(1.5)))))
+	assert.Equal(t, option.Some(2.5), FromDoubleValue(ToDoubleValue(option.Some(2.5))))
+
+	assert.Nil(t, ToInt64Value(option.None[int64]()))
+	assert.Equal(t, option.None[int64](), FromInt64Value(nil))
+}
+
+func TestTimestamp(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	assert.Equal(t, option.Some(now), FromTimestamp(ToTimestamp(option.Some(now))))
+	assert.Nil(t, ToTimestamp(option.None[time.Time]()))
+	assert.Equal(t, option.None[time.Time](), FromTimestamp(nil))
+}
+
+func TestDuration(t *testing.T) {
+	assert.Equal(t, option.Some(90*time.Second), FromDuration(ToDuration(option.Some(90*time.Second))))
+	assert.Nil(t, ToDuration(option.None[time.Duration]()))
+	assert.Equal(t, option.None[time.Duration](), FromDuration(nil))
+}
+
+func TestOptional(t *testing.T) {
+	ptr := ToOptional(option.Some("Billy"))
+	assert.Equal(t, "Billy", *ptr)
+	assert.Nil(t, ToOptional(option.None[string]()))
+
+	assert.Equal(t, option.Some("Billy"), FromOptional(ptr))
+	assert.Equal(t, option.None[string](), FromOptional[string](nil))
+}
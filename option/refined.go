@@ -0,0 +1,40 @@
+package option
+
+import (
+	"encoding/json"
+
+	"github.com/jkratz55/gonads"
+)
+
+// Refined is a value that is guaranteed to have satisfied a set of predicates
+// when it was created. Refined can be used in signatures to encode validated
+// values, ie a non-empty string or a positive int, without re-checking them.
+//
+// A Refined can only be created with Refine, the zero value doesn't carry the
+// guarantee.
+type Refined[T any] struct {
+	val T
+}
+
+// Refine returns Some containing a Refined value if val satisfies all the
+// predicates, otherwise returns None.
+func Refine[T any](val T, preds ...gonads.Predicate[T]) Option[Refined[T]] {
+	for _, pred := range preds {
+		if !pred(val) {
+			return None[Refined[T]]()
+		}
+	}
+	return Some(Refined[T]{val: val})
+}
+
+// Value returns the refined value.
+func (r Refined[T]) Value() T {
+	return r.val
+}
+
+// MarshalJSON marshals the refined value to JSON. Refined doesn't implement
+// json.Unmarshaler since decoding can't re-establish the guarantee, instead decode
+// the raw value and pass it to Refine.
+func (r Refined[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.val)
+}
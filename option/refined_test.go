@@ -0,0 +1,39 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefine(t *testing.T) {
+	positive := func(val int) bool { return val > 0 }
+	even := func(val int) bool { return val%2 == 0 }
+
+	tests := []struct {
+		name     string
+		val      int
+		expected Option[int]
+	}{
+		{name: "Satisfies All", val: 4, expected: Some(4)},
+		{name: "Fails First", val: -4, expected: None[int]()},
+		{name: "Fails Second", val: 3, expected: None[int]()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			refined := Refine(test.val, positive, even)
+			assert.Equal(t, test.expected, Map(refined, Refined[int].Value))
+		})
+	}
+
+	assert.True(t, Refine("anything").IsSome())
+}
+
+func TestRefined_MarshalJSON(t *testing.T) {
+	refined := Refine("Billy", func(val string) bool { return val != "" }).Unwrap()
+	data, err := json.Marshal(refined)
+	assert.NoError(t, err)
+	assert.Equal(t, `"Billy"`, string(data))
+}
@@ -0,0 +1,104 @@
+package option
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+const redacted = "***"
+
+// Secret is an optional sensitive value, such as a token or password, that is
+// redacted whenever it is formatted, logged, or encoded. The value can only be
+// accessed explicitly using Expose or Unwrap.
+//
+// Secret is redacted by String, Format (all verbs including %#v), MarshalJSON,
+// MarshalText, and slog. A Secret containing a value is rendered as "***" and
+// a Secret that is None as "None" (null in JSON). Decoding from JSON reads the
+// actual value, so secrets can be loaded from configuration.
+//
+// The zero value is a Secret that is None.
+type Secret[T any] struct {
+	opt Option[T]
+}
+
+// NewSecret creates a Secret containing val.
+func NewSecret[T any](val T) Secret[T] {
+	return Secret[T]{opt: Some(val)}
+}
+
+// SecretFrom creates a Secret from an Option.
+func SecretFrom[T any](opt Option[T]) Secret[T] {
+	return Secret[T]{opt: opt}
+}
+
+// IsSome returns a boolean indicating if the Secret contains a value.
+func (s Secret[T]) IsSome() bool {
+	return s.opt.exists
+}
+
+// IsNone returns a boolean indicating if the Secret doesn't contain a value.
+func (s Secret[T]) IsNone() bool {
+	return !s.opt.exists
+}
+
+// Expose returns the unredacted Option.
+func (s Secret[T]) Expose() Option[T] {
+	return s.opt
+}
+
+// Unwrap returns the unredacted value, or panics if the Secret is None.
+func (s Secret[T]) Unwrap() T {
+	return s.opt.Unwrap()
+}
+
+// String returns "***" if the Secret contains a value, otherwise "None".
+func (s Secret[T]) String() string {
+	if !s.opt.exists {
+		return "None"
+	}
+	return redacted
+}
+
+// GoString implements fmt.GoStringer returning the redacted representation.
+func (s Secret[T]) GoString() string {
+	return s.String()
+}
+
+// Format implements fmt.Formatter so every verb is redacted.
+func (s Secret[T]) Format(f fmt.State, verb rune) {
+	_, _ = f.Write([]byte(s.String()))
+}
+
+// LogValue implements slog.LogValuer so the Secret is redacted in structured logs.
+func (s Secret[T]) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// MarshalJSON marshals the Secret as "***", or null if it is None.
+func (s Secret[T]) MarshalJSON() ([]byte, error) {
+	if !s.opt.exists {
+		return jsonNull, nil
+	}
+	return json.Marshal(redacted)
+}
+
+// UnmarshalJSON unmarshalls the actual value of the Secret from JSON, null is
+// decoded as None.
+func (s *Secret[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&s.opt, data, EncodeNull)
+}
+
+// MarshalText marshals the Secret as "***", or an empty string if it is None.
+func (s Secret[T]) MarshalText() ([]byte, error) {
+	if !s.opt.exists {
+		return []byte{}, nil
+	}
+	return []byte(redacted), nil
+}
+
+// UnmarshalText unmarshalls the actual value of the Secret from text, the same as
+// Option.UnmarshalText.
+func (s *Secret[T]) UnmarshalText(text []byte) error {
+	return s.opt.UnmarshalText(text)
+}
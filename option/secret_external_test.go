@@ -0,0 +1,38 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/patch"
+)
+
+// Secret resembles an Option but has no Get, the generic helpers that inspect
+// Options must neither panic on it nor reveal its value.
+func TestSecret_GenericHelpers(t *testing.T) {
+	type account struct {
+		User     string
+		Password option.Secret[string]
+	}
+	a := account{User: "billy", Password: option.NewSecret("hunter2")}
+
+	var out string
+	assert.NotPanics(t, func() { out = gonads.Dump(option.Some(a)) })
+	assert.NotContains(t, out, "hunter2")
+
+	assert.NotPanics(t, func() {
+		assert.True(t, gonads.DeepEqual(a, account{User: "billy", Password: option.NewSecret("hunter2")}))
+		assert.False(t, gonads.DeepEqual(a, account{User: "billy", Password: option.NewSecret("letmein")}))
+	})
+
+	var err error
+	assert.NotPanics(t, func() {
+		err = patch.Apply(&a, struct{ Password option.Secret[string] }{option.NewSecret("letmein")})
+	})
+	assert.ErrorIs(t, err, patch.ErrTypeMismatch)
+	assert.NotContains(t, err.Error(), "letmein")
+	assert.Equal(t, "hunter2", a.Password.Unwrap())
+}
@@ -0,0 +1,65 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type credentials struct {
+	User     string         `json:"user"`
+	Password Secret[string] `json:"password"`
+}
+
+func TestSecret_Redacted(t *testing.T) {
+	s := NewSecret("hunter2")
+
+	assert.Equal(t, "***", s.String())
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x"} {
+		assert.Equal(t, "***", fmt.Sprintf(format, s), format)
+	}
+	assert.NotContains(t, fmt.Sprintf("%+v", credentials{User: "Billy", Password: s}), "hunter2")
+	assert.Equal(t, "None", fmt.Sprint(Secret[string]{}))
+
+	data, err := json.Marshal(credentials{User: "Billy", Password: s})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"user":"Billy","password":"***"}`, string(data))
+
+	text, err := s.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "***", string(text))
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("login", "password", s)
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.Contains(t, buf.String(), `"password":"***"`)
+}
+
+func TestSecret_Expose(t *testing.T) {
+	s := NewSecret("hunter2")
+	assert.True(t, s.IsSome())
+	assert.Equal(t, Some("hunter2"), s.Expose())
+	assert.Equal(t, "hunter2", s.Unwrap())
+
+	none := SecretFrom(None[string]())
+	assert.True(t, none.IsNone())
+	assert.Panics(t, func() { none.Unwrap() })
+}
+
+func TestSecret_Unmarshal(t *testing.T) {
+	var creds credentials
+	assert.NoError(t, json.Unmarshal([]byte(`{"user":"Billy","password":"hunter2"}`), &creds))
+	assert.Equal(t, "hunter2", creds.Password.Unwrap())
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"password":null}`), &creds))
+	assert.True(t, creds.Password.IsNone())
+
+	var s Secret[string]
+	assert.NoError(t, s.UnmarshalText([]byte("token")))
+	assert.Equal(t, Some("token"), s.Expose())
+}
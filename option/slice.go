@@ -0,0 +1,120 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// Slice is a sequence of Options stored as a flat slice of values alongside a
+// compact presence bitset. For large optional columns a Slice uses roughly half
+// the memory of a []Option[T] since the presence flag of each element doesn't
+// need to be padded to the alignment of T.
+//
+// Slice is encoded to and decoded from JSON the same as a []Option[T]. The zero
+// value is an empty Slice ready to use.
+type Slice[T any] struct {
+	values  []T
+	present bitset
+}
+
+// SliceOf creates a Slice containing the provided Options.
+func SliceOf[T any](opts ...Option[T]) Slice[T] {
+	s := Slice[T]{values: make([]T, 0, len(opts))}
+	s.Append(opts...)
+	return s
+}
+
+// Len returns the number of Options in the Slice.
+func (s Slice[T]) Len() int {
+	return len(s.values)
+}
+
+// Get returns the Option at index i. Get panics if i is out of range.
+func (s Slice[T]) Get(i int) Option[T] {
+	s.checkIndex(i)
+	if !s.present.get(i) {
+		return None[T]()
+	}
+	return Option[T]{val: s.values[i], exists: true}
+}
+
+// Set replaces the Option at index i and returns the Option previously at that
+// index. Set panics if i is out of range.
+func (s *Slice[T]) Set(i int, opt Option[T]) Option[T] {
+	prev := s.Get(i)
+	s.values[i] = opt.val
+	s.present.set(i, opt.exists)
+	return prev
+}
+
+// Append appends the provided Options to the end of the Slice.
+func (s *Slice[T]) Append(opts ...Option[T]) {
+	for _, opt := range opts {
+		s.present.set(len(s.values), opt.exists)
+		s.values = append(s.values, opt.val)
+	}
+}
+
+// All returns an iterator over the indexes and Options of the Slice in order.
+func (s Slice[T]) All() iter.Seq2[int, Option[T]] {
+	return func(yield func(int, Option[T]) bool) {
+		for i := range s.values {
+			if !yield(i, s.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Options returns the contents of the Slice as a []Option[T].
+func (s Slice[T]) Options() []Option[T] {
+	opts := make([]Option[T], len(s.values))
+	for i := range s.values {
+		opts[i] = s.Get(i)
+	}
+	return opts
+}
+
+// MarshalJSON marshals the Slice to a JSON array encoding each element the same
+// as an Option.
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	if s.values == nil {
+		return jsonNull, nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i := range s.values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		data, err := marshalJSON(s.Get(i), jsonEncoding())
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshalls a JSON array of Options to the Slice.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	var opts []Option[T]
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return err
+	}
+	if opts == nil {
+		*s = Slice[T]{}
+		return nil
+	}
+	*s = SliceOf(opts...)
+	return nil
+}
+
+func (s Slice[T]) checkIndex(i int) {
+	if i < 0 || i >= len(s.values) {
+		panic(fmt.Sprintf("index %d out of range [0:%d]", i, len(s.values)))
+	}
+}
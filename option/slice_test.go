@@ -0,0 +1,92 @@
+package option
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlice(t *testing.T) {
+	var s Slice[int]
+	assert.Equal(t, 0, s.Len())
+
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			s.Append(None[int]())
+			continue
+		}
+		s.Append(Some(i))
+	}
+	assert.Equal(t, 100, s.Len())
+	assert.Equal(t, None[int](), s.Get(0))
+	assert.Equal(t, Some(99), s.Get(99))
+	assert.Panics(t, func() { s.Get(100) })
+
+	prev := s.Set(0, Some(1000))
+	assert.Equal(t, None[int](), prev)
+	assert.Equal(t, Some(1000), s.Get(0))
+
+	prev = s.Set(99, None[int]())
+	assert.Equal(t, Some(99), prev)
+	assert.Equal(t, None[int](), s.Get(99))
+}
+
+func TestSlice_All(t *testing.T) {
+	s := SliceOf(Some("a"), None[string](), Some("c"))
+
+	var got []Option[string]
+	for i, opt := range s.All() {
+		assert.Equal(t, s.Get(i), opt)
+		got = append(got, opt)
+	}
+	assert.Equal(t, s.Options(), got)
+
+	for i := range s.All() {
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestSlice_JSON(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option[string]
+	}{
+		{name: "Nil", opts: nil},
+		{name: "Empty", opts: []Option[string]{}},
+		{name: "Mixed", opts: []Option[string]{Some("Billy"), None[string](), Some("<Bob>")}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected, err := json.Marshal(test.opts)
+			assert.NoError(t, err)
+
+			var s Slice[string]
+			if test.opts != nil {
+				s = SliceOf(test.opts...)
+			}
+			actual, err := json.Marshal(s)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+
+			var decoded Slice[string]
+			assert.NoError(t, json.Unmarshal(actual, &decoded))
+			assert.Equal(t, s.Options(), decoded.Options())
+		})
+	}
+}
+
+func TestSlice_JSONExplicit(t *testing.T) {
+	SetEncoding(EncodeExplicit)
+	t.Cleanup(func() { SetEncoding(EncodeNull) })
+
+	opts := []Option[int]{Some(1), None[int]()}
+	expected, err := json.Marshal(opts)
+	assert.NoError(t, err)
+	actual, err := json.Marshal(SliceOf(opts...))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
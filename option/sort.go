@@ -0,0 +1,72 @@
+package option
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/jkratz55/gonads"
+)
+
+// NonePolicy determines where None values are placed relative to Some values
+// when comparing or sorting Options.
+type NonePolicy int
+
+const (
+	// NoneFirst orders None before any Some value.
+	NoneFirst NonePolicy = iota
+	// NoneLast orders None after any Some value.
+	NoneLast
+)
+
+// ComparatorOption adapts a Comparator for T into a Comparator for Option[T].
+// Two None values are considered equal, and the placement of None relative to
+// Some is determined by the NonePolicy. When both Options are Some the provided
+// Comparator is invoked with their values.
+func ComparatorOption[T any](fn gonads.Comparator[T], policy NonePolicy) gonads.Comparator[Option[T]] {
+	return func(a, b Option[T]) int {
+		switch {
+		case !a.exists && !b.exists:
+			return 0
+		case !a.exists:
+			if policy == NoneLast {
+				return 1
+			}
+			return -1
+		case !b.exists:
+			if policy == NoneLast {
+				return -1
+			}
+			return 1
+		default:
+			return fn(a.val, b.val)
+		}
+	}
+}
+
+// Equal reports whether two Options are equal. Two Options are equal if both are
+// None, or both are Some with equal values.
+func Equal[T comparable](a, b Option[T]) bool {
+	if a.exists != b.exists {
+		return false
+	}
+	return !a.exists || a.val == b.val
+}
+
+// Compare compares two Options returning -1 if a is less than b, 0 if they are
+// equal, and +1 if a is greater than b. None is considered less than any Some,
+// and two Some values are compared using cmp.Compare. Compare can be used
+// directly with slices.SortFunc.
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return ComparatorOption[T](cmp.Compare[T], NoneFirst)(a, b)
+}
+
+// SortSlice sorts a slice of Options in place in ascending order of their values.
+// When noneLast is true None values are placed at the end of the slice, otherwise
+// at the beginning. The sort is stable so equal elements keep their original order.
+func SortSlice[T cmp.Ordered](opts []Option[T], noneLast bool) {
+	policy := NoneFirst
+	if noneLast {
+		policy = NoneLast
+	}
+	slices.SortStableFunc(opts, ComparatorOption[T](cmp.Compare[T], policy))
+}
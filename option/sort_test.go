@@ -0,0 +1,54 @@
+package option
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparatorOption(t *testing.T) {
+	noneFirst := ComparatorOption[int](cmp.Compare[int], NoneFirst)
+	assert.Equal(t, 0, noneFirst(None[int](), None[int]()))
+	assert.Equal(t, -1, noneFirst(None[int](), Some(1)))
+	assert.Equal(t, 1, noneFirst(Some(1), None[int]()))
+	assert.Equal(t, -1, noneFirst(Some(1), Some(2)))
+	assert.Equal(t, 0, noneFirst(Some(2), Some(2)))
+
+	noneLast := ComparatorOption[int](cmp.Compare[int], NoneLast)
+	assert.Equal(t, 0, noneLast(None[int](), None[int]()))
+	assert.Equal(t, 1, noneLast(None[int](), Some(1)))
+	assert.Equal(t, -1, noneLast(Some(1), None[int]()))
+	assert.Equal(t, 1, noneLast(Some(3), Some(2)))
+}
+
+func TestSortSlice(t *testing.T) {
+	opts := []Option[int]{Some(3), None[int](), Some(1), None[int](), Some(2)}
+	SortSlice(opts, true)
+	assert.Equal(t, []Option[int]{Some(1), Some(2), Some(3), None[int](), None[int]()}, opts)
+
+	opts = []Option[int]{Some(3), None[int](), Some(1), Some(2)}
+	SortSlice(opts, false)
+	assert.Equal(t, []Option[int]{None[int](), Some(1), Some(2), Some(3)}, opts)
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(None[int](), None[int]()))
+	assert.True(t, Equal(Some(1), Some(1)))
+	assert.False(t, Equal(Some(1), Some(2)))
+	assert.False(t, Equal(Some(1), None[int]()))
+	assert.False(t, Equal(None[int](), Some(1)))
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 0, Compare(None[string](), None[string]()))
+	assert.Equal(t, -1, Compare(None[string](), Some("a")))
+	assert.Equal(t, 1, Compare(Some("a"), None[string]()))
+	assert.Equal(t, -1, Compare(Some("a"), Some("b")))
+	assert.Equal(t, 0, Compare(Some("a"), Some("a")))
+
+	opts := []Option[string]{Some("b"), None[string](), Some("a")}
+	slices.SortFunc(opts, Compare[string])
+	assert.Equal(t, []Option[string]{None[string](), Some("a"), Some("b")}, opts)
+}
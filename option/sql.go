@@ -0,0 +1,113 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface allowing an Option to be used as a
+// destination for nullable database columns. A NULL value results in None,
+// anything else results in Some.
+//
+// Conversion from the driver value to T follows the same rules as database/sql,
+// so common primitive types, time.Time, and types implementing sql.Scanner are
+// supported.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = Some(n.V)
+	return nil
+}
+
+// Value implements the driver.Valuer interface allowing an Option to be used as a
+// query argument. None is converted to NULL. If the value of the Option
+// implements driver.Valuer it is used, otherwise the value is converted to a
+// driver.Value using driver.DefaultParameterConverter.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil
+	}
+	if valuer, ok := any(o.val).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.val)
+}
+
+// FromNull converts a sql.Null into an Option. If the sql.Null is valid, returns
+// Some containing the value, otherwise None.
+func FromNull[T any](n sql.Null[T]) Option[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+	return Some(n.V)
+}
+
+// ToNull converts an Option into a sql.Null that is valid only if the Option is
+// Some.
+func ToNull[T any](o Option[T]) sql.Null[T] {
+	return sql.Null[T]{V: o.val, Valid: o.exists}
+}
+
+// FromNullString converts a sql.NullString into an Option.
+func FromNullString(n sql.NullString) Option[string] {
+	return fromValid(n.String, n.Valid)
+}
+
+// ToNullString converts an Option into a sql.NullString.
+func ToNullString(o Option[string]) sql.NullString {
+	return sql.NullString{String: o.val, Valid: o.exists}
+}
+
+// FromNullInt64 converts a sql.NullInt64 into an Option.
+func FromNullInt64(n sql.NullInt64) Option[int64] {
+	return fromValid(n.Int64, n.Valid)
+}
+
+// ToNullInt64 converts an Option into a sql.NullInt64.
+func ToNullInt64(o Option[int64]) sql.NullInt64 {
+	return sql.NullInt64{Int64: o.val, Valid: o.exists}
+}
+
+// FromNullFloat64 converts a sql.NullFloat64 into an Option.
+func FromNullFloat64(n sql.NullFloat64) Option[float64] {
+	return fromValid(n.Float64, n.Valid)
+}
+
+// ToNullFloat64 converts an Option into a sql.NullFloat64.
+func ToNullFloat64(o Option[float64]) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: o.val, Valid: o.exists}
+}
+
+// FromNullBool converts a sql.NullBool into an Option.
+func FromNullBool(n sql.NullBool) Option[bool] {
+	return fromValid(n.Bool, n.Valid)
+}
+
+// ToNullBool converts an Option into a sql.NullBool.
+func ToNullBool(o Option[bool]) sql.NullBool {
+	return sql.NullBool{Bool: o.val, Valid: o.exists}
+}
+
+// FromNullTime converts a sql.NullTime into an Option.
+func FromNullTime(n sql.NullTime) Option[time.Time] {
+	return fromValid(n.Time, n.Valid)
+}
+
+// ToNullTime converts an Option into a sql.NullTime.
+func ToNullTime(o Option[time.Time]) sql.NullTime {
+	return sql.NullTime{Time: o.val, Valid: o.exists}
+}
+
+func fromValid[T any](val T, valid bool) Option[T] {
+	if !valid {
+		return None[T]()
+	}
+	return Some(val)
+}
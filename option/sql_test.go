@@ -0,0 +1,131 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption_Scan(t *testing.T) {
+	var str Option[string]
+	assert.NoError(t, str.Scan(nil))
+	assert.Equal(t, None[string](), str)
+	assert.NoError(t, str.Scan([]byte("Billy")))
+	assert.Equal(t, Some("Billy"), str)
+	assert.NoError(t, str.Scan("Bob"))
+	assert.Equal(t, Some("Bob"), str)
+
+	var num Option[int]
+	assert.NoError(t, num.Scan(int64(42)))
+	assert.Equal(t, Some(42), num)
+	assert.NoError(t, num.Scan([]byte("7")))
+	assert.Equal(t, Some(7), num)
+	assert.Error(t, num.Scan("not a number"))
+
+	now := time.Now()
+	var ts Option[time.Time]
+	assert.NoError(t, ts.Scan(now))
+	assert.Equal(t, Some(now), ts)
+
+	var b Option[bool]
+	assert.NoError(t, b.Scan(int64(1)))
+	assert.Equal(t, Some(true), b)
+
+	var ns Option[sql.NullString]
+	assert.NoError(t, ns.Scan("Billy"))
+	assert.Equal(t, Some(sql.NullString{String: "Billy", Valid: true}), ns)
+}
+
+func TestOption_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		valuer   driver.Valuer
+		expected driver.Value
+	}{
+		{
+			name:     "None",
+			valuer:   None[string](),
+			expected: nil,
+		},
+		{
+			name:     "String",
+			valuer:   Some("Billy"),
+			expected: "Billy",
+		},
+		{
+			name:     "Int",
+			valuer:   Some(42),
+			expected: int64(42),
+		},
+		{
+			name:     "Float32",
+			valuer:   Some(float32(1.5)),
+			expected: float64(1.5),
+		},
+		{
+			name:     "Bool",
+			valuer:   Some(true),
+			expected: true,
+		},
+		{
+			name:     "Valuer",
+			valuer:   Some(sql.NullString{String: "Bob", Valid: true}),
+			expected: "Bob",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			val, err := test.valuer.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, val)
+		})
+	}
+
+	now := time.Now()
+	val, err := Some(now).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, now, val)
+}
+
+func TestFromNull(t *testing.T) {
+	assert.Equal(t, Some(42), FromNull(sql.Null[int]{V: 42, Valid: true}))
+	assert.Equal(t, None[int](), FromNull(sql.Null[int]{V: 42}))
+}
+
+func TestToNull(t *testing.T) {
+	assert.Equal(t, sql.Null[int]{V: 42, Valid: true}, ToNull(Some(42)))
+	assert.Equal(t, sql.Null[int]{}, ToNull(None[int]()))
+}
+
+func TestNullConverters(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, Some("Billy"), FromNullString(sql.NullString{String: "Billy", Valid: true}))
+	assert.Equal(t, None[string](), FromNullString(sql.NullString{}))
+	assert.Equal(t, sql.NullString{String: "Billy", Valid: true}, ToNullString(Some("Billy")))
+	assert.Equal(t, sql.NullString{}, ToNullString(None[string]()))
+
+	assert.Equal(t, Some(int64(42)), FromNullInt64(sql.NullInt64{Int64: 42, Valid: true}))
+	assert.Equal(t, None[int64](), FromNullInt64(sql.NullInt64{}))
+	assert.Equal(t, sql.NullInt64{Int64: 42, Valid: true}, ToNullInt64(Some(int64(42))))
+	assert.Equal(t, sql.NullInt64{}, ToNullInt64(None[int64]()))
+
+	assert.Equal(t, Some(1.5), FromNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true}))
+	assert.Equal(t, None[float64](), FromNullFloat64(sql.NullFloat64{}))
+	assert.Equal(t, sql.NullFloat64{Float64: 1.5, Valid: true}, ToNullFloat64(Some(1.5)))
+	assert.Equal(t, sql.NullFloat64{}, ToNullFloat64(None[float64]()))
+
+	assert.Equal(t, Some(false), FromNullBool(sql.NullBool{Bool: false, Valid: true}))
+	assert.Equal(t, None[bool](), FromNullBool(sql.NullBool{}))
+	assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, ToNullBool(Some(true)))
+	assert.Equal(t, sql.NullBool{}, ToNullBool(None[bool]()))
+
+	assert.Equal(t, Some(now), FromNullTime(sql.NullTime{Time: now, Valid: true}))
+	assert.Equal(t, None[time.Time](), FromNullTime(sql.NullTime{}))
+	assert.Equal(t, sql.NullTime{Time: now, Valid: true}, ToNullTime(Some(now)))
+	assert.Equal(t, sql.NullTime{}, ToNullTime(None[time.Time]()))
+}
@@ -0,0 +1,57 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jkratz55/gonads/internal/textvalue"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface. None is encoded as
+// an empty string. If the value implements encoding.TextMarshaler it is used,
+// time.Duration is formatted using its String method, ie "30s", and strings,
+// booleans, and numeric types are formatted using strconv.
+//
+// Because None is encoded as an empty string, Some("") can't be distinguished
+// from None once encoded as text.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return []byte{}, nil
+	}
+	return marshalText(o.val)
+}
+
+func marshalText[T any](val T) ([]byte, error) {
+	text, err := textvalue.Format(reflect.ValueOf(val))
+	if errors.Is(err, textvalue.ErrUnsupported) {
+		return nil, fmt.Errorf("cannot marshal Option value of type %T to text", val)
+	}
+	return text, err
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. An empty string
+// is decoded as None. If *T implements encoding.TextUnmarshaler it is used,
+// time.Duration is parsed using time.ParseDuration, and strings, booleans, and
+// numeric types are parsed using strconv.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = None[T]()
+		return nil
+	}
+	v, err := unmarshalText[T](text)
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+func unmarshalText[T any](text []byte) (T, error) {
+	var v T
+	err := textvalue.Parse(reflect.ValueOf(&v).Elem(), string(text))
+	if errors.Is(err, textvalue.ErrUnsupported) {
+		return v, fmt.Errorf("cannot unmarshal text into Option value of type %T", v)
+	}
+	return v, err
+}
@@ -0,0 +1,89 @@
+package option
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type level string
+
+func TestOption_MarshalText(t *testing.T) {
+	ts := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		opt      interface{ MarshalText() ([]byte, error) }
+		expected string
+	}{
+		{name: "None", opt: None[int](), expected: ""},
+		{name: "String", opt: Some("Billy"), expected: "Billy"},
+		{name: "Named String", opt: Some(level("debug")), expected: "debug"},
+		{name: "Bool", opt: Some(true), expected: "true"},
+		{name: "Int", opt: Some(-42), expected: "-42"},
+		{name: "Uint", opt: Some(uint8(42)), expected: "42"},
+		{name: "Float", opt: Some(1.5), expected: "1.5"},
+		{name: "TextMarshaler", opt: Some(ts), expected: "2023-01-02T03:04:05Z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, err := test.opt.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(text))
+		})
+	}
+
+	_, err := Some([]string{"a"}).MarshalText()
+	assert.Error(t, err)
+}
+
+func TestOption_UnmarshalText(t *testing.T) {
+	var str Option[string]
+	assert.NoError(t, str.UnmarshalText([]byte("Billy")))
+	assert.Equal(t, Some("Billy"), str)
+	assert.NoError(t, str.UnmarshalText([]byte("")))
+	assert.Equal(t, None[string](), str)
+
+	var lvl Option[level]
+	assert.NoError(t, lvl.UnmarshalText([]byte("debug")))
+	assert.Equal(t, Some(level("debug")), lvl)
+
+	var b Option[bool]
+	assert.NoError(t, b.UnmarshalText([]byte("true")))
+	assert.Equal(t, Some(true), b)
+
+	var i Option[int8]
+	assert.NoError(t, i.UnmarshalText([]byte("-8")))
+	assert.Equal(t, Some(int8(-8)), i)
+	assert.Error(t, i.UnmarshalText([]byte("1000")))
+
+	var u Option[uint]
+	assert.NoError(t, u.UnmarshalText([]byte("8")))
+	assert.Equal(t, Some(uint(8)), u)
+
+	var f Option[float64]
+	assert.NoError(t, f.UnmarshalText([]byte("1.5")))
+	assert.Equal(t, Some(1.5), f)
+
+	var ip Option[net.IP]
+	assert.NoError(t, ip.UnmarshalText([]byte("127.0.0.1")))
+	assert.Equal(t, "127.0.0.1", ip.Unwrap().String())
+	assert.Error(t, ip.UnmarshalText([]byte("not an ip")))
+
+	var s Option[[]string]
+	assert.Error(t, s.UnmarshalText([]byte("a")))
+}
+
+func TestOption_TextDuration(t *testing.T) {
+	var d Option[time.Duration]
+	assert.NoError(t, d.UnmarshalText([]byte("30s")))
+	assert.Equal(t, Some(30*time.Second), d)
+	assert.Error(t, d.UnmarshalText([]byte("thirty")))
+
+	text, err := Some(90 * time.Second).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1m30s", string(text))
+}
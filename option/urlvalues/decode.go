@@ -0,0 +1,111 @@
+package urlvalues
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+	"github.com/jkratz55/gonads/internal/textvalue"
+	"github.com/jkratz55/gonads/validated"
+)
+
+// DefaultMaxMemory is the maximum number of bytes of a multipart form stored in
+// memory by DecodeForm, the remainder is stored on disk in temporary files.
+const DefaultMaxMemory = 32 << 20
+
+var (
+	// ErrInvalidTarget is returned when Decode is called with something other than
+	// a non-nil pointer to a struct.
+	ErrInvalidTarget = errors.New("values can only be decoded into a non-nil pointer to a struct")
+	// ErrUnsupportedType is reported for a field whose type can't be decoded from
+	// a form value.
+	ErrUnsupportedType = errors.New("unsupported field type")
+)
+
+// Decode populates the exported fields of the struct pointed to by dst from the
+// values. Fields are matched to keys by their name, or by the value of their
+// `form` struct tag, a tag of "-" skips the field. The first value of each key is
+// decoded based on the type of the field:
+//
+//	option.Option - None if the key is absent or its value is empty, otherwise Some
+//	encoding.TextUnmarshaler - Decoded using UnmarshalText
+//	time.Duration - Parsed using time.ParseDuration
+//	string, bool, ints, uints, floats - Parsed using strconv
+//
+// Fields that aren't Options are left unchanged when their key is absent.
+//
+//	type Search struct {
+//		Query string                `form:"q"`
+//		Page  option.Option[int]    `form:"page"`
+//		Since option.Option[string] `form:"since"`
+//	}
+//
+// If any field can't be decoded dst is left unchanged and a validated.Errors is
+// returned containing every problem found, with field names that are the keys of
+// the values.
+func Decode(values url.Values, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+	dv := rv.Elem()
+
+	// Decode into a copy so dst is only modified when every field succeeded.
+	tmp := reflect.New(dv.Type()).Elem()
+	tmp.Set(dv)
+
+	errs := make(validated.Errors, 0)
+	typ := tmp.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		key := sf.Name
+		if tag, ok := sf.Tag.Lookup("form"); ok {
+			if tag == "-" {
+				continue
+			}
+			key = tag
+		}
+
+		fv := tmp.Field(i)
+		isOption := reflectopt.IsOption(fv.Type())
+		if !values.Has(key) {
+			if isOption {
+				fv.SetZero()
+			}
+			continue
+		}
+		if err := decodeValue(fv, values.Get(key)); err != nil {
+			errs = append(errs, validated.FieldError{Field: key, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	dv.Set(tmp)
+	return nil
+}
+
+// DecodeForm parses the form of the request, including multipart forms, and
+// decodes the form values into dst using Decode. Multipart forms are parsed using
+// DefaultMaxMemory.
+func DecodeForm(r *http.Request, dst any) error {
+	err := r.ParseMultipartForm(DefaultMaxMemory)
+	if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return fmt.Errorf("parse form: %w", err)
+	}
+	return Decode(r.Form, dst)
+}
+
+func decodeValue(fv reflect.Value, raw string) error {
+	err := textvalue.Parse(fv, raw)
+	if errors.Is(err, textvalue.ErrUnsupported) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedType, fv.Type())
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package urlvalues
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/validated"
+)
+
+type search struct {
+	Query   string                   `form:"q"`
+	Page    option.Option[int]       `form:"page"`
+	Active  option.Option[bool]      `form:"active"`
+	Since   option.Option[time.Time] `form:"since"`
+	Limit   uint8
+	Ignored string `form:"-"`
+}
+
+func TestDecode(t *testing.T) {
+	values := url.Values{
+		"q":       {"gonads"},
+		"page":    {"2"},
+		"active":  {""},
+		"since":   {"2024-01-02T00:00:00Z"},
+		"Limit":   {"50"},
+		"Ignored": {"boom"},
+	}
+
+	dst := search{Page: option.Some(1), Active: option.Some(true)}
+	assert.NoError(t, Decode(values, &dst))
+	assert.Equal(t, search{
+		Query:  "gonads",
+		Page:   option.Some(2),
+		Active: option.None[bool](),
+		Since:  option.Some(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+		Limit:  50,
+	}, dst)
+}
+
+func TestDecode_Duration(t *testing.T) {
+	var dst struct {
+		Timeout  option.Option[time.Duration] `form:"timeout"`
+		Interval time.Duration                `form:"interval"`
+	}
+	assert.NoError(t, Decode(url.Values{"timeout": {"30s"}, "interval": {"1m"}}, &dst))
+	assert.Equal(t, option.Some(30*time.Second), dst.Timeout)
+	assert.Equal(t, time.Minute, dst.Interval)
+	assert.Error(t, Decode(url.Values{"interval": {"60"}}, &dst))
+}
+
+func TestDecode_Absent(t *testing.T) {
+	dst := search{Query: "keep", Page: option.Some(1)}
+	assert.NoError(t, Decode(url.Values{}, &dst))
+	assert.Equal(t, "keep", dst.Query)
+	assert.Equal(t, option.None[int](), dst.Page)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	dst := search{Query: "keep"}
+	err := Decode(url.Values{"q": {"changed"}, "page": {"abc"}, "Limit": {"300"}}, &dst)
+
+	var errs validated.Errors
+	assert.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "page", errs[0].Field)
+	assert.ErrorIs(t, errs[0], strconv.ErrSyntax)
+	assert.Equal(t, "Limit", errs[1].Field)
+	assert.ErrorIs(t, errs[1], strconv.ErrRange)
+	assert.Equal(t, "keep", dst.Query)
+
+	var unsupported struct {
+		Tags []string
+	}
+	err = Decode(url.Values{"Tags": {"a"}}, &unsupported)
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+
+	assert.ErrorIs(t, Decode(url.Values{}, nil), ErrInvalidTarget)
+	assert.ErrorIs(t, Decode(url.Values{}, dst), ErrInvalidTarget)
+	assert.ErrorIs(t, Decode(url.Values{}, new(int)), ErrInvalidTarget)
+}
+
+func TestDecodeForm(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/search", strings.NewReader("q=gonads&page=3"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var dst search
+	assert.NoError(t, DecodeForm(r, &dst))
+	assert.Equal(t, "gonads", dst.Query)
+	assert.Equal(t, option.Some(3), dst.Page)
+	assert.True(t, dst.Active.IsNone())
+}
+
+func TestDecodeForm_Multipart(t *testing.T) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	assert.NoError(t, w.WriteField("q", "gonads"))
+	assert.NoError(t, w.WriteField("active", "true"))
+	assert.NoError(t, w.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/search", &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+
+	var dst search
+	assert.NoError(t, DecodeForm(r, &dst))
+	assert.Equal(t, "gonads", dst.Query)
+	assert.Equal(t, option.Some(true), dst.Active)
+	assert.True(t, dst.Page.IsNone())
+}
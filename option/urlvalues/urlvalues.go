@@ -0,0 +1,87 @@
+// Package urlvalues provides helpers for reading optional query parameters and
+// form values from url.Values into Options, and for encoding Options back into
+// url.Values skipping those that are None. Decode and DecodeForm populate structs
+// with Option fields from query parameters and form data.
+package urlvalues
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// FromQuery returns Some containing the first value associated with the key, or
+// None if the key isn't present. A key that is present with an empty value is
+// Some, use Filter to treat empty values as None.
+func FromQuery(values url.Values, key string) option.Option[string] {
+	if !values.Has(key) {
+		return option.None[string]()
+	}
+	return option.Some(values.Get(key))
+}
+
+// Parse returns the first value associated with the key parsed using the provided
+// parse function. If the key isn't present or its value is empty Ok(None) is
+// returned. If the value is present but parse fails an Error Result is returned,
+// since the value was provided but is invalid.
+func Parse[T any](values url.Values, key string, parse func(string) (T, error)) result.Result[option.Option[T]] {
+	raw := values.Get(key)
+	if raw == "" {
+		return result.Ok(option.None[T]())
+	}
+	val, err := parse(raw)
+	if err != nil {
+		return result.Error[option.Option[T]](fmt.Errorf("value %q: %w", key, err))
+	}
+	return result.Ok(option.Some(val))
+}
+
+// Int returns the value associated with the key parsed as an int.
+func Int(values url.Values, key string) result.Result[option.Option[int]] {
+	return Parse(values, key, strconv.Atoi)
+}
+
+// Bool returns the value associated with the key parsed as a bool using
+// strconv.ParseBool.
+func Bool(values url.Values, key string) result.Result[option.Option[bool]] {
+	return Parse(values, key, strconv.ParseBool)
+}
+
+// Time returns the value associated with the key parsed as a time.Time using the
+// provided layout.
+func Time(values url.Values, key string, layout string) result.Result[option.Option[time.Time]] {
+	return Parse(values, key, func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+}
+
+// Set sets the key to the text encoding of the value if the Option is Some,
+// otherwise the key is removed. The value is encoded using Option.MarshalText.
+func Set[T any](values url.Values, key string, opt option.Option[T]) error {
+	if opt.IsNone() {
+		values.Del(key)
+		return nil
+	}
+	text, err := opt.MarshalText()
+	if err != nil {
+		return fmt.Errorf("value %q: %w", key, err)
+	}
+	values.Set(key, string(text))
+	return nil
+}
+
+// Encode encodes the params into URL encoded form ("bar=baz&foo=quux") sorted by
+// key. Params that are None are skipped.
+func Encode(params map[string]option.Option[string]) string {
+	values := make(url.Values, len(params))
+	for key, opt := range params {
+		opt.IfSome(func(val string) {
+			values.Set(key, val)
+		})
+	}
+	return values.Encode()
+}
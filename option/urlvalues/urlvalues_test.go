@@ -0,0 +1,64 @@
+package urlvalues
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestFromQuery(t *testing.T) {
+	values := url.Values{"name": {"Billy", "Bob"}, "empty": {""}}
+
+	assert.Equal(t, option.Some("Billy"), FromQuery(values, "name"))
+	assert.Equal(t, option.Some(""), FromQuery(values, "empty"))
+	assert.Equal(t, option.None[string](), FromQuery(values, "missing"))
+}
+
+func TestTyped(t *testing.T) {
+	values := url.Values{
+		"page":   {"2"},
+		"active": {"true"},
+		"since":  {"2024-01-02"},
+		"bad":    {"abc"},
+		"blank":  {""},
+	}
+
+	assert.Equal(t, option.Some(2), Int(values, "page").Unwrap())
+	assert.Equal(t, option.Some(true), Bool(values, "active").Unwrap())
+	assert.Equal(t, option.Some(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), Time(values, "since", time.DateOnly).Unwrap())
+
+	assert.Equal(t, option.None[int](), Int(values, "missing").Unwrap())
+	assert.Equal(t, option.None[int](), Int(values, "blank").Unwrap())
+
+	_, err := Int(values, "bad").Get()
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Contains(t, err.Error(), `"bad"`)
+
+	_, err = Bool(values, "bad").Get()
+	assert.Error(t, err)
+	_, err = Time(values, "bad", time.DateOnly).Get()
+	assert.Error(t, err)
+}
+
+func TestSet(t *testing.T) {
+	values := url.Values{"page": {"1"}}
+
+	assert.NoError(t, Set(values, "limit", option.Some(50)))
+	assert.NoError(t, Set(values, "page", option.None[int]()))
+	assert.Equal(t, url.Values{"limit": {"50"}}, values)
+}
+
+func TestEncode(t *testing.T) {
+	s := Encode(map[string]option.Option[string]{
+		"q":      option.Some("gonads go"),
+		"cursor": option.None[string](),
+		"sort":   option.Some("name"),
+	})
+	assert.Equal(t, "q=gonads+go&sort=name", s)
+	assert.Equal(t, "", Encode(nil))
+}
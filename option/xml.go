@@ -0,0 +1,64 @@
+package option
+
+import (
+	"encoding/xml"
+)
+
+// MarshalXML implements the xml.Marshaler interface. None omits the element
+// entirely, Some encodes the value as the element.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.exists {
+		return nil
+	}
+	return e.EncodeElement(o.val, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. The element is decoded
+// into the value resulting in Some. A missing element leaves the Option untouched,
+// which is None if it wasn't initialized.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface. None omits the
+// attribute entirely. If the value implements xml.MarshalerAttr it is used,
+// otherwise the value is encoded the same as MarshalText.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.exists {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(o.val).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	text, err := marshalText(o.val)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface. A present
+// attribute always results in Some, even if its value is empty. If *T implements
+// xml.UnmarshalerAttr it is used, otherwise the value is decoded the same as
+// UnmarshalText.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var v T
+	if u, ok := any(&v).(xml.UnmarshalerAttr); ok {
+		if err := u.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+		*o = Some(v)
+		return nil
+	}
+	v, err := unmarshalText[T]([]byte(attr.Value))
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
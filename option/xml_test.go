@@ -0,0 +1,49 @@
+package option
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type xmlAddress struct {
+	City string `xml:"city"`
+}
+
+type xmlPerson struct {
+	XMLName  xml.Name           `xml:"person"`
+	ID       Option[int]        `xml:"id,attr"`
+	Nickname Option[string]     `xml:"nickname,attr"`
+	Name     string             `xml:"name"`
+	Age      Option[int]        `xml:"age"`
+	Address  Option[xmlAddress] `xml:"address"`
+}
+
+func TestOption_MarshalXML(t *testing.T) {
+	p := xmlPerson{
+		ID:       Some(7),
+		Nickname: None[string](),
+		Name:     "Billy",
+		Age:      None[int](),
+		Address:  Some(xmlAddress{City: "Springfield"}),
+	}
+	data, err := xml.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `<person id="7"><name>Billy</name><address><city>Springfield</city></address></person>`, string(data))
+}
+
+func TestOption_UnmarshalXML(t *testing.T) {
+	var p xmlPerson
+	data := `<person id="7" nickname=""><name>Billy</name><age>30</age></person>`
+	assert.NoError(t, xml.Unmarshal([]byte(data), &p))
+	assert.Equal(t, Some(7), p.ID)
+	assert.Equal(t, Some(""), p.Nickname)
+	assert.Equal(t, "Billy", p.Name)
+	assert.Equal(t, Some(30), p.Age)
+	assert.Equal(t, None[xmlAddress](), p.Address)
+
+	p = xmlPerson{}
+	assert.Error(t, xml.Unmarshal([]byte(`<person id="abc"></person>`), &p))
+	assert.Error(t, xml.Unmarshal([]byte(`<person><age>abc</age></person>`), &p))
+}
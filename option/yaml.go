@@ -0,0 +1,32 @@
+package option
+
+// MarshalYAML implements the yaml.Marshaler interface. None is encoded as null
+// and Some is encoded as the value itself.
+func (o Option[T]) MarshalYAML() (any, error) {
+	if !o.exists {
+		return nil, nil
+	}
+	return o.val, nil
+}
+
+// UnmarshalYAML implements the obsolete yaml.Unmarshaler interface, which is still
+// supported by gopkg.in/yaml.v3 and avoids the option package depending on it. A
+// null node is decoded as None, anything else is decoded into the value and
+// results in Some. A missing node leaves the Option untouched, which is None if
+// it wasn't initialized.
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var raw any
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
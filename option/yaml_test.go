@@ -0,0 +1,51 @@
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlConfig struct {
+	Host    string           `yaml:"host"`
+	Port    Option[int]      `yaml:"port"`
+	Timeout Option[string]   `yaml:"timeout"`
+	Tags    Option[[]string] `yaml:"tags"`
+}
+
+func TestOption_UnmarshalYAML(t *testing.T) {
+	data := []byte(`
+host: localhost
+port: 8080
+timeout: null
+tags:
+  - a
+  - b
+`)
+	var cfg yamlConfig
+	assert.NoError(t, yaml.Unmarshal(data, &cfg))
+	assert.Equal(t, "localhost", cfg.Host)
+	assert.Equal(t, Some(8080), cfg.Port)
+	assert.Equal(t, None[string](), cfg.Timeout)
+	assert.Equal(t, Some([]string{"a", "b"}), cfg.Tags)
+
+	cfg = yamlConfig{}
+	assert.NoError(t, yaml.Unmarshal([]byte(`host: localhost`), &cfg))
+	assert.True(t, cfg.Port.IsNone())
+	assert.True(t, cfg.Timeout.IsNone())
+
+	assert.Error(t, yaml.Unmarshal([]byte(`port: abc`), &cfg))
+}
+
+func TestOption_MarshalYAML(t *testing.T) {
+	cfg := yamlConfig{
+		Host:    "localhost",
+		Port:    Some(8080),
+		Timeout: None[string](),
+		Tags:    Some([]string{"a"}),
+	}
+	data, err := yaml.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "host: localhost\nport: 8080\ntimeout: null\ntags:\n    - a\n", string(data))
+}
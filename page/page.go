@@ -0,0 +1,60 @@
+package page
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// Page is a single page of items returned from a paginated API along with the
+// cursor for the next page. Next is None when there are no more pages.
+type Page[T, C any] struct {
+	Items []T
+	Next  option.Option[C]
+}
+
+// Fetcher retrieves a page of items. The cursor is None when fetching the first
+// page, and Some with the cursor returned by the previous page otherwise.
+type Fetcher[T, C any] func(ctx context.Context, cursor option.Option[C]) result.Result[Page[T, C]]
+
+// Iterate lazily walks every page returned by fetch yielding each item as an Ok
+// Result. Pages are only fetched as the items of the previous page are consumed,
+// and iteration ends once a page without a next cursor is reached.
+//
+// If fetch fails, or the context is done before fetching the next page, an Error
+// Result is yielded and iteration ends.
+//
+//	for res := range page.Iterate(ctx, listUsers) {
+//		user, err := res.Get()
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func Iterate[T, C any](ctx context.Context, fetch Fetcher[T, C]) iter.Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		cursor := option.None[C]()
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(result.Error[T](err))
+				return
+			}
+			p, err := fetch(ctx, cursor).Get()
+			if err != nil {
+				yield(result.Error[T](err))
+				return
+			}
+			for _, item := range p.Items {
+				if !yield(result.Ok(item)) {
+					return
+				}
+			}
+			if p.Next.IsNone() {
+				return
+			}
+			cursor = p.Next
+		}
+	}
+}
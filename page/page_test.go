@@ -0,0 +1,78 @@
+package page
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+func pagedFetcher(pages [][]int, calls *int, failAt int) Fetcher[int, int] {
+	return func(ctx context.Context, cursor option.Option[int]) result.Result[Page[int, int]] {
+		*calls++
+		idx := cursor.UnwrapOrDefault(0)
+		if idx == failAt {
+			return result.Error[Page[int, int]](errors.New("fetch failed"))
+		}
+		p := Page[int, int]{Items: pages[idx], Next: option.None[int]()}
+		if idx+1 < len(pages) {
+			p.Next = option.Some(idx + 1)
+		}
+		return result.Ok(p)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	calls := 0
+	fetch := pagedFetcher([][]int{{1, 2}, {}, {3}}, &calls, -1)
+
+	items := make([]int, 0)
+	for res := range Iterate(context.Background(), fetch) {
+		items = append(items, res.Unwrap())
+	}
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 3, calls)
+}
+
+func TestIterate_StopsEarly(t *testing.T) {
+	calls := 0
+	fetch := pagedFetcher([][]int{{1, 2}, {3}}, &calls, -1)
+
+	for res := range Iterate(context.Background(), fetch) {
+		if res.Unwrap() == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestIterate_Error(t *testing.T) {
+	calls := 0
+	fetch := pagedFetcher([][]int{{1}, {2}, {3}}, &calls, 1)
+
+	results := make([]result.Result[int], 0)
+	for res := range Iterate(context.Background(), fetch) {
+		results = append(results, res)
+	}
+	assert.Len(t, results, 2)
+	assert.True(t, results[0].IsOk())
+	assert.True(t, results[1].IsErr())
+	assert.Equal(t, 2, calls)
+}
+
+func TestIterate_ContextDone(t *testing.T) {
+	calls := 0
+	fetch := pagedFetcher([][]int{{1}}, &calls, -1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for res := range Iterate(ctx, fetch) {
+		_, err := res.Get()
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	assert.Equal(t, 0, calls)
+}
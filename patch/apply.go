@@ -0,0 +1,249 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jkratz55/gonads/internal/reflectopt"
+	"github.com/jkratz55/gonads/validated"
+)
+
+var (
+	// ErrInvalidTarget is returned when Apply is called with a nil destination or
+	// with types that aren't structs.
+	ErrInvalidTarget = errors.New("patch can only be applied from a struct to a non-nil pointer to a struct")
+	// ErrUnknownField is reported for a patch field without a matching destination
+	// field.
+	ErrUnknownField = errors.New("has no matching destination field")
+	// ErrTypeMismatch is reported for a patch field whose value can't be assigned
+	// to the matching destination field.
+	ErrTypeMismatch = errors.New("type doesn't match the destination field")
+)
+
+// optionValue is satisfied by option.Option, and by types such as option.Secret
+// that resemble an Option but whose value can't be read.
+type optionValue interface {
+	IsSome() bool
+}
+
+// stateValue is satisfied by Value.
+type stateValue interface {
+	patchState() (any, state)
+}
+
+func (v Value[T]) patchState() (any, state) {
+	return v.val, v.state
+}
+
+// Apply copies the fields of the patch struct that are present onto the struct
+// pointed to by dst. Patch fields are matched to destination fields by name, or
+// by the value of their `patch` struct tag. Fields of the patch are handled based
+// on their type:
+//
+//	option.Option - Some is copied, None is skipped
+//	Value - A value is copied, Null clears the destination field, Undefined is skipped
+//	struct - Applied recursively to the matching destination struct
+//
+// A nested patch struct whose destination is a nil pointer to a struct allocates
+// a new struct for the destination if the patch sets any of its fields. Fields
+// promoted through a nil embedded pointer can't be patched and are reported as
+// ErrUnknownField.
+//
+// Other patch fields are ignored. Values are copied to destination fields of the
+// same type, to option.Option fields as Some, and to pointer fields as a pointer
+// to a copy of the value. Clearing sets the destination field to its zero value,
+// which is None for option.Option fields and nil for pointers.
+//
+//	type UserPatch struct {
+//		Name     option.Option[string]
+//		Nickname patch.Value[string]
+//	}
+//
+// Apply validates the whole patch before modifying dst, if any field can't be
+// applied dst is left unchanged and a validated.Errors is returned containing
+// every problem found, with field names that are the dotted path to the field.
+func Apply[S, P any](dst *S, patch P) error {
+	if dst == nil {
+		return ErrInvalidTarget
+	}
+	dv := reflect.ValueOf(dst).Elem()
+	pv := reflect.ValueOf(patch)
+	if dv.Kind() != reflect.Struct || pv.Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+
+	var assignments []func()
+	errs := plan(dv, pv, "", &assignments)
+	if len(errs) > 0 {
+		return errs
+	}
+	for _, assign := range assignments {
+		assign()
+	}
+	return nil
+}
+
+func plan(dv, pv reflect.Value, prefix string, assignments *[]func()) validated.Errors {
+	errs := make(validated.Errors, 0)
+	pt := pv.Type()
+	for i := 0; i < pt.NumField(); i++ {
+		sf := pt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("patch"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		path := prefix + name
+		fv := pv.Field(i)
+
+		if _, ok := fv.Interface().(optionValue); ok && !reflectopt.IsOption(fv.Type()) {
+			errs = append(errs, validated.FieldError{
+				Field: path,
+				Err:   fmt.Errorf("%w: cannot read the value of %s", ErrTypeMismatch, fv.Type()),
+			})
+			continue
+		}
+
+		val, present, isPatch := fieldValue(fv)
+		if !isPatch && fv.Kind() != reflect.Struct {
+			continue
+		}
+
+		target, err := field(dv, name)
+		if err != nil {
+			errs = append(errs, validated.FieldError{Field: path, Err: err})
+			continue
+		}
+
+		if !isPatch {
+			if target.Kind() == reflect.Pointer && target.Type().Elem().Kind() == reflect.Struct {
+				if target.IsNil() {
+					// Plan against a new struct which is only assigned if the
+					// nested patch sets any of its fields.
+					ptr := reflect.New(target.Type().Elem())
+					n := len(*assignments)
+					errs = append(errs, plan(ptr.Elem(), fv, path+".", assignments)...)
+					if len(*assignments) > n {
+						*assignments = append(*assignments, func() { target.Set(ptr) })
+					}
+					continue
+				}
+				target = target.Elem()
+			}
+			if target.Kind() != reflect.Struct {
+				errs = append(errs, validated.FieldError{Field: path, Err: ErrTypeMismatch})
+				continue
+			}
+			errs = append(errs, plan(target, fv, path+".", assignments)...)
+			continue
+		}
+		if !present {
+			continue
+		}
+
+		assign, err := assigner(target, val)
+		if err != nil {
+			errs = append(errs, validated.FieldError{Field: path, Err: err})
+			continue
+		}
+		*assignments = append(*assignments, assign)
+	}
+	return errs
+}
+
+// field returns the settable field of dv with the given name, including fields
+// promoted from embedded structs.
+func field(dv reflect.Value, name string) (reflect.Value, error) {
+	sf, ok := dv.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, ErrUnknownField
+	}
+	target, err := dv.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("%w: promoted through a nil embedded pointer", ErrUnknownField)
+	}
+	if !target.CanSet() {
+		return reflect.Value{}, ErrUnknownField
+	}
+	return target, nil
+}
+
+// fieldValue returns the value a patch field should be applied with. present is
+// false when the field should be skipped, and an invalid value with present true
+// means the destination field should be cleared. isPatch is false if the field
+// isn't an Option or Value.
+func fieldValue(fv reflect.Value) (val reflect.Value, present bool, isPatch bool) {
+	if !fv.CanInterface() {
+		return reflect.Value{}, false, false
+	}
+	switch p := fv.Interface().(type) {
+	case stateValue:
+		v, st := p.patchState()
+		switch st {
+		case value:
+			return reflect.ValueOf(&v).Elem().Elem(), true, true
+		case null:
+			return reflect.Value{}, true, true
+		default:
+			return reflect.Value{}, false, true
+		}
+	default:
+		val, ok, isOption := reflectopt.Option(fv)
+		return val, ok, isOption
+	}
+}
+
+// assigner returns a func that assigns val to target, or clears target if val is
+// invalid.
+func assigner(target, val reflect.Value) (func(), error) {
+	tt := target.Type()
+	if !val.IsValid() {
+		return func() { target.SetZero() }, nil
+	}
+	if val.Kind() == reflect.Interface {
+		// A Value of an interface type holding nil is cleared.
+		if val.IsNil() {
+			return func() { target.SetZero() }, nil
+		}
+		val = val.Elem()
+	}
+
+	vt := val.Type()
+	switch {
+	case vt.AssignableTo(tt):
+		return func() { target.Set(val) }, nil
+	case isOptionOf(tt, vt):
+		return func() {
+			opt := reflect.New(tt)
+			opt.MethodByName("Replace").Call([]reflect.Value{val})
+			target.Set(opt.Elem())
+		}, nil
+	case tt.Kind() == reflect.Pointer && vt.AssignableTo(tt.Elem()):
+		return func() {
+			ptr := reflect.New(tt.Elem())
+			ptr.Elem().Set(val)
+			target.Set(ptr)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: cannot assign %s to %s", ErrTypeMismatch, vt, tt)
+	}
+}
+
+// isOptionOf reports whether typ is an option.Option containing values of type
+// elem.
+func isOptionOf(typ, elem reflect.Type) bool {
+	if !reflectopt.IsOption(typ) {
+		return false
+	}
+	replace, ok := reflect.PointerTo(typ).MethodByName("Replace")
+	if !ok || replace.Type.NumIn() != 2 {
+		return false
+	}
+	return elem.AssignableTo(replace.Type.In(1))
+}
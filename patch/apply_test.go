@@ -0,0 +1,158 @@
+package patch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/validated"
+)
+
+type address struct {
+	City string
+	Zip  string
+}
+
+type user struct {
+	Name     string
+	Nickname option.Option[string]
+	Email    *string
+	Age      int
+	Address  address
+	Manager  *address
+}
+
+type addressPatch struct {
+	City option.Option[string]
+	Zip  Value[string]
+}
+
+type userUpdate struct {
+	Name     option.Option[string]
+	Nickname Value[string]
+	Email    Value[string]
+	Years    option.Option[int] `patch:"Age"`
+	Address  addressPatch
+	Manager  addressPatch
+	Ignored  string
+}
+
+func newUser() user {
+	email := "billy@example.com"
+	return user{
+		Name:     "Billy",
+		Nickname: option.Some("Bill"),
+		Email:    &email,
+		Age:      30,
+		Address:  address{City: "Pittsburgh", Zip: "15201"},
+		Manager:  &address{City: "Cleveland"},
+	}
+}
+
+func TestApply(t *testing.T) {
+	u := newUser()
+	err := Apply(&u, userUpdate{
+		Name:     option.None[string](),
+		Nickname: Null[string](),
+		Email:    Of("bob@example.com"),
+		Years:    option.Some(31),
+		Address:  addressPatch{City: option.Some("Columbus"), Zip: Null[string]()},
+		Manager:  addressPatch{Zip: Of("44101")},
+		Ignored:  "ignored",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Billy", u.Name)
+	assert.Equal(t, option.None[string](), u.Nickname)
+	assert.Equal(t, "bob@example.com", *u.Email)
+	assert.Equal(t, 31, u.Age)
+	assert.Equal(t, address{City: "Columbus"}, u.Address)
+	assert.Equal(t, &address{City: "Cleveland", Zip: "44101"}, u.Manager)
+}
+
+func TestApply_OptionDestination(t *testing.T) {
+	u := newUser()
+	assert.NoError(t, Apply(&u, struct {
+		Nickname option.Option[string]
+		Email    Value[string]
+	}{
+		Nickname: option.Some("B"),
+		Email:    Null[string](),
+	}))
+	assert.Equal(t, option.Some("B"), u.Nickname)
+	assert.Nil(t, u.Email)
+}
+
+func TestApply_Errors(t *testing.T) {
+	u := newUser()
+	err := Apply(&u, struct {
+		Name    option.Option[string]
+		Missing option.Option[string]
+		Age     Value[string]
+	}{
+		Name:    option.Some("Bob"),
+		Missing: option.Some("x"),
+		Age:     Of("thirty"),
+	})
+
+	var errs validated.Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 2)
+	assert.ErrorIs(t, err, ErrUnknownField)
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Contains(t, errs.Fields(), "Missing")
+	assert.Contains(t, errs.Fields(), "Age")
+
+	// dst is unchanged when any field can't be applied
+	assert.Equal(t, "Billy", u.Name)
+}
+
+func TestApply_NilStructPointer(t *testing.T) {
+	u := newUser()
+	u.Manager = nil
+	assert.NoError(t, Apply(&u, userUpdate{Manager: addressPatch{}}))
+	assert.Nil(t, u.Manager)
+
+	assert.NoError(t, Apply(&u, userUpdate{Manager: addressPatch{City: option.Some("Akron")}}))
+	assert.Equal(t, &address{City: "Akron"}, u.Manager)
+}
+
+func TestApply_NilEmbeddedPointer(t *testing.T) {
+	type outer struct {
+		*address
+	}
+	var o outer
+	err := Apply(&o, struct {
+		City option.Option[string]
+	}{
+		City: option.Some("Akron"),
+	})
+	assert.ErrorIs(t, err, ErrUnknownField)
+	assert.Nil(t, o.address)
+}
+
+func TestApply_Secret(t *testing.T) {
+	type account struct {
+		Password option.Secret[string]
+	}
+	a := account{Password: option.NewSecret("hunter2")}
+	err := Apply(&a, struct {
+		Password option.Secret[string]
+	}{
+		Password: option.NewSecret("letmein"),
+	})
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Equal(t, "hunter2", a.Password.Unwrap())
+}
+
+func TestApply_InvalidTarget(t *testing.T) {
+	assert.ErrorIs(t, Apply[user](nil, userUpdate{}), ErrInvalidTarget)
+
+	n := 1
+	assert.ErrorIs(t, Apply(&n, userUpdate{}), ErrInvalidTarget)
+
+	u := newUser()
+	assert.ErrorIs(t, Apply(&u, 1), ErrInvalidTarget)
+}
@@ -0,0 +1,141 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+var jsonNull = []byte("null")
+
+type state uint8
+
+const (
+	undefined state = iota
+	null
+	value
+)
+
+// Value is a tri-state field for partial updates that distinguishes a field that
+// was absent (Undefined) from one explicitly set to null (Null) and one set to a
+// value. A two-state Option can't tell an absent key from an explicit null, which
+// PATCH semantics require, ie absent means "leave unchanged" while null means
+// "clear".
+//
+// When decoding JSON a missing key leaves the Value Undefined, null decodes as
+// Null and anything else as a value. When encoding use the `omitzero` tag option
+// so Undefined Values are omitted. The zero value is Undefined.
+type Value[T any] struct {
+	val   T
+	state state
+}
+
+// Undefined creates a Value that is absent.
+func Undefined[T any]() Value[T] {
+	return Value[T]{}
+}
+
+// Null creates a Value that was explicitly set to null.
+func Null[T any]() Value[T] {
+	return Value[T]{state: null}
+}
+
+// Of creates a Value set to val.
+func Of[T any](val T) Value[T] {
+	return Value[T]{val: val, state: value}
+}
+
+// FromOption creates a Value from an Option. Some is converted to a Value set to
+// the value of the Option and None is converted to Null.
+func FromOption[T any](opt option.Option[T]) Value[T] {
+	val, ok := opt.Get()
+	if !ok {
+		return Null[T]()
+	}
+	return Of(val)
+}
+
+// IsUndefined returns a boolean indicating if the Value is absent.
+func (v Value[T]) IsUndefined() bool {
+	return v.state == undefined
+}
+
+// IsNull returns a boolean indicating if the Value was explicitly set to null.
+func (v Value[T]) IsNull() bool {
+	return v.state == null
+}
+
+// IsSet returns a boolean indicating if the Value was set to a value.
+func (v Value[T]) IsSet() bool {
+	return v.state == value
+}
+
+// IsZero returns a boolean indicating if the Value is Undefined. IsZero allows
+// Undefined Values to be omitted when encoding JSON using the `omitzero` tag
+// option.
+func (v Value[T]) IsZero() bool {
+	return v.state == undefined
+}
+
+// Get returns the value along with a boolean indicating if the Value was set to a
+// value.
+func (v Value[T]) Get() (T, bool) {
+	return v.val, v.state == value
+}
+
+// Option returns Some containing the value if the Value was set to a value,
+// otherwise None.
+func (v Value[T]) Option() option.Option[T] {
+	if v.state != value {
+		return option.None[T]()
+	}
+	return option.Some(v.val)
+}
+
+// Present returns None if the Value is Undefined, otherwise Some containing the
+// Option the field should be updated to, which is None for Null.
+func (v Value[T]) Present() option.Option[option.Option[T]] {
+	if v.state == undefined {
+		return option.None[option.Option[T]]()
+	}
+	return option.Some(v.Option())
+}
+
+// String returns Undefined, Null, or Value(v).
+func (v Value[T]) String() string {
+	switch v.state {
+	case null:
+		return "Null"
+	case value:
+		return fmt.Sprintf("Value(%v)", v.val)
+	default:
+		return "Undefined"
+	}
+}
+
+// MarshalJSON marshals the Value to JSON. Null and Undefined are encoded as null,
+// use the `omitzero` tag option to omit Undefined Values.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	if v.state != value {
+		return jsonNull, nil
+	}
+	return json.Marshal(v.val)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Value. null is decoded as Null and
+// anything else as a value. UnmarshalJSON isn't invoked for missing keys, so they
+// remain Undefined.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*v = Null[T]()
+		return nil
+	}
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*v = Of(val)
+	return nil
+}
@@ -0,0 +1,74 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+type userPatch struct {
+	Name     Value[string] `json:"name,omitzero"`
+	Nickname Value[string] `json:"nickname,omitzero"`
+	Age      Value[int]    `json:"age,omitzero"`
+}
+
+func TestValue_UnmarshalJSON(t *testing.T) {
+	var p userPatch
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Billy","nickname":null}`), &p))
+
+	assert.True(t, p.Name.IsSet())
+	assert.Equal(t, option.Some("Billy"), p.Name.Option())
+	assert.True(t, p.Nickname.IsNull())
+	assert.Equal(t, option.None[string](), p.Nickname.Option())
+	assert.True(t, p.Age.IsUndefined())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"old"}`), &p))
+}
+
+func TestValue_MarshalJSON(t *testing.T) {
+	p := userPatch{Name: Of("Billy"), Nickname: Null[string]()}
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Billy","nickname":null}`, string(data))
+}
+
+func TestValue_States(t *testing.T) {
+	tests := []struct {
+		name      string
+		val       Value[int]
+		undefined bool
+		null      bool
+		set       bool
+		present   option.Option[option.Option[int]]
+		str       string
+	}{
+		{name: "Undefined", val: Undefined[int](), undefined: true, present: option.None[option.Option[int]](), str: "Undefined"},
+		{name: "Null", val: Null[int](), null: true, present: option.Some(option.None[int]()), str: "Null"},
+		{name: "Value", val: Of(42), set: true, present: option.Some(option.Some(42)), str: "Value(42)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.undefined, test.val.IsUndefined())
+			assert.Equal(t, test.undefined, test.val.IsZero())
+			assert.Equal(t, test.null, test.val.IsNull())
+			assert.Equal(t, test.set, test.val.IsSet())
+			assert.Equal(t, test.present, test.val.Present())
+			assert.Equal(t, test.str, test.val.String())
+		})
+	}
+
+	var zero Value[int]
+	assert.True(t, zero.IsUndefined())
+	val, ok := Of(1).Get()
+	assert.Equal(t, 1, val)
+	assert.True(t, ok)
+}
+
+func TestFromOption(t *testing.T) {
+	assert.Equal(t, Of("Billy"), FromOption(option.Some("Billy")))
+	assert.Equal(t, Null[string](), FromOption(option.None[string]()))
+}
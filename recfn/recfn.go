@@ -0,0 +1,152 @@
+package recfn
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+
+	"github.com/jkratz55/gonads/internal/textvalue"
+	"github.com/jkratz55/gonads/result"
+	"github.com/jkratz55/gonads/validated"
+)
+
+var (
+	// ErrEmptyValue is reported when a cell mapped to a field that isn't an Option
+	// or string is empty.
+	ErrEmptyValue = errors.New("value is empty")
+	// ErrInvalidType is returned when T isn't a struct.
+	ErrInvalidType = errors.New("records can only be decoded into a struct")
+)
+
+// Options configures how records are read.
+type Options struct {
+	// Comma is the field delimiter. Defaults to ',', use '\t' for TSV.
+	Comma rune
+	// Comment, if not 0, is the comment character. Lines beginning with the
+	// comment character are ignored.
+	Comment rune
+}
+
+// Decode reads CSV/TSV records from r and decodes each row into a struct of type
+// T, yielding a Result for every row. The first row must be a header, columns are
+// mapped to exported struct fields using the `csv` struct tag, or the field name
+// if no tag is present. Columns without a matching field are ignored and fields
+// tagged `csv:"-"` are skipped.
+//
+// Empty cells are decoded as None for Option fields. Cells that can't be parsed
+// produce an Error Result for the row containing a validated.Errors with the
+// column name of every malformed cell, and decoding continues with the next row.
+// Errors reading the header or malformed CSV end the decoding.
+//
+// Rows are read lazily so arbitrarily large files can be decoded with constant
+// memory.
+func Decode[T any](r io.Reader, opts Options) iter.Seq[result.Result[T]] {
+	return func(yield func(result.Result[T]) bool) {
+		typ := reflect.TypeFor[T]()
+		if typ.Kind() != reflect.Struct {
+			yield(result.Error[T](ErrInvalidType))
+			return
+		}
+
+		reader := csv.NewReader(r)
+		if opts.Comma != 0 {
+			reader.Comma = opts.Comma
+		}
+		reader.Comment = opts.Comment
+		reader.ReuseRecord = true
+
+		header, err := reader.Read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				yield(result.Error[T](fmt.Errorf("read header: %w", err)))
+			}
+			return
+		}
+		columns := mapColumns(typ, header)
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				var parseErr *csv.ParseError
+				recoverable := errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount)
+				if !yield(result.Error[T](err)) || !recoverable {
+					return
+				}
+				continue
+			}
+			line, _ := reader.FieldPos(0)
+			if !yield(decodeRow[T](record, columns, line)) {
+				return
+			}
+		}
+	}
+}
+
+type column struct {
+	name  string
+	index []int
+}
+
+func mapColumns(typ reflect.Type, header []string) []*column {
+	fields := make(map[string][]int)
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		fields[name] = sf.Index
+	}
+
+	columns := make([]*column, len(header))
+	for i, name := range header {
+		if index, ok := fields[name]; ok {
+			columns[i] = &column{name: name, index: index}
+		}
+	}
+	return columns
+}
+
+func decodeRow[T any](record []string, columns []*column, line int) result.Result[T] {
+	var dst T
+	rv := reflect.ValueOf(&dst).Elem()
+	errs := make(validated.Errors, 0)
+	for i, cell := range record {
+		if i >= len(columns) || columns[i] == nil {
+			continue
+		}
+		if err := setField(rv.FieldByIndex(columns[i].index), cell); err != nil {
+			errs = append(errs, validated.FieldError{Field: columns[i].name, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return result.Error[T](fmt.Errorf("line %d: %w", line, errs))
+	}
+	return result.Ok(dst)
+}
+
+func setField(fv reflect.Value, cell string) error {
+	if _, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); !ok && cell == "" && fv.Kind() != reflect.String {
+		return ErrEmptyValue
+	}
+	err := textvalue.Parse(fv, cell)
+	if errors.Is(err, textvalue.ErrUnsupported) {
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+	return err
+}
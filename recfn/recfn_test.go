@@ -0,0 +1,128 @@
+package recfn
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+	"github.com/jkratz55/gonads/validated"
+)
+
+type employee struct {
+	Name    string                `csv:"name"`
+	Age     int                   `csv:"age"`
+	Email   option.Option[string] `csv:"email"`
+	Salary  option.Option[float64]
+	Skipped string `csv:"-"`
+}
+
+func collect[T any](seq func(func(result.Result[T]) bool)) []result.Result[T] {
+	out := make([]result.Result[T], 0)
+	for res := range seq {
+		out = append(out, res)
+	}
+	return out
+}
+
+func TestDecode(t *testing.T) {
+	data := "name,age,email,Salary,Skipped,unknown\n" +
+		"Billy,30,billy@example.com,1000.5,x,y\n" +
+		"Bob,40,,,x,y\n"
+
+	results := collect(Decode[employee](strings.NewReader(data), Options{}))
+	assert.Len(t, results, 2)
+	assert.Equal(t, employee{
+		Name:   "Billy",
+		Age:    30,
+		Email:  option.Some("billy@example.com"),
+		Salary: option.Some(1000.5),
+	}, results[0].Unwrap())
+	assert.Equal(t, employee{
+		Name:   "Bob",
+		Age:    40,
+		Email:  option.None[string](),
+		Salary: option.None[float64](),
+	}, results[1].Unwrap())
+}
+
+func TestDecode_Duration(t *testing.T) {
+	type job struct {
+		Name    string                       `csv:"name"`
+		Runtime time.Duration                `csv:"runtime"`
+		Timeout option.Option[time.Duration] `csv:"timeout"`
+	}
+	data := "name,runtime,timeout\n" +
+		"backup,1m30s,5m\n" +
+		"cleanup,10s,\n"
+
+	results := collect(Decode[job](strings.NewReader(data), Options{}))
+	assert.Len(t, results, 2)
+	assert.Equal(t, job{Name: "backup", Runtime: 90 * time.Second, Timeout: option.Some(5 * time.Minute)}, results[0].Unwrap())
+	assert.Equal(t, job{Name: "cleanup", Runtime: 10 * time.Second}, results[1].Unwrap())
+}
+
+func TestDecode_TSV(t *testing.T) {
+	data := "name\tage\nBilly\t30\n"
+	results := collect(Decode[employee](strings.NewReader(data), Options{Comma: '\t'}))
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Billy", results[0].Unwrap().Name)
+}
+
+func TestDecode_MalformedCells(t *testing.T) {
+	data := "name,age,Salary\n" +
+		"Billy,abc,lots\n" +
+		"Bob,,\n" +
+		"Joe,50,\n"
+
+	results := collect(Decode[employee](strings.NewReader(data), Options{}))
+	assert.Len(t, results, 3)
+
+	_, err := results[0].Get()
+	var errs validated.Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, []string{"age", "Salary"}, []string{errs[0].Field, errs[1].Field})
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Contains(t, err.Error(), "line 2")
+
+	_, err = results[1].Get()
+	assert.ErrorIs(t, err, ErrEmptyValue)
+
+	assert.Equal(t, 50, results[2].Unwrap().Age)
+}
+
+func TestDecode_FieldCount(t *testing.T) {
+	data := "name,age\nBilly,30,extra\nBob,40\n"
+	results := collect(Decode[employee](strings.NewReader(data), Options{}))
+	assert.Len(t, results, 2)
+	assert.True(t, results[0].IsErr())
+	assert.Equal(t, "Bob", results[1].Unwrap().Name)
+}
+
+func TestDecode_StopEarly(t *testing.T) {
+	data := "name,age\nBilly,30\nBob,40\n"
+	count := 0
+	for range Decode[employee](strings.NewReader(data), Options{}) {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	results := collect(Decode[int](strings.NewReader("a\n1\n"), Options{}))
+	assert.Len(t, results, 1)
+	_, err := results[0].Get()
+	assert.ErrorIs(t, err, ErrInvalidType)
+
+	assert.Empty(t, collect(Decode[employee](strings.NewReader(""), Options{})))
+
+	malformed := collect(Decode[employee](strings.NewReader("name,\"age\n"), Options{}))
+	assert.Len(t, malformed, 1)
+	assert.True(t, malformed[0].IsErr())
+}
@@ -0,0 +1,115 @@
+package respond
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// Envelope is the standard body of an API response. A successful response is
+// encoded as {"data":...} and a failed response as
+// {"error":{"code":"...","message":"..."}}, regardless of the process-wide
+// option.Encoding. Data is None when the successful value is nil, and is encoded
+// as {"data":null}.
+type Envelope[T any] struct {
+	Data  option.Option[T]
+	Error option.Option[Error]
+}
+
+// MarshalJSON marshals the Envelope to JSON.
+func (e Envelope[T]) MarshalJSON() ([]byte, error) {
+	if err, ok := e.Error.Get(); ok {
+		return json.Marshal(struct {
+			Error Error `json:"error"`
+		}{Error: err})
+	}
+	val, _ := e.Data.Get()
+	return json.Marshal(struct {
+		Data any `json:"data"`
+	}{Data: val})
+}
+
+// Error describes a failed response. Status is the HTTP status code used by Write
+// and isn't part of the encoded envelope.
+type Error struct {
+	Status  int    `json:"-"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorMapper maps an error to the Error reported to clients.
+type ErrorMapper func(err error) Error
+
+// internalError is reported for errors that aren't mapped, so internal details
+// aren't leaked to clients.
+var internalError = Error{
+	Status:  http.StatusInternalServerError,
+	Code:    "internal",
+	Message: "internal server error",
+}
+
+// DefaultErrorMapper maps every error to a generic internal server error without
+// exposing the error message.
+func DefaultErrorMapper(error) Error {
+	return internalError
+}
+
+// Rule maps errors matching Target, as determined by errors.Is, to Error.
+type Rule struct {
+	Target error
+	Error  Error
+}
+
+// Rules returns an ErrorMapper that maps an error using the first matching Rule,
+// or the fallback if no Rule matches. If fallback is nil DefaultErrorMapper is
+// used.
+func Rules(fallback ErrorMapper, rules ...Rule) ErrorMapper {
+	if fallback == nil {
+		fallback = DefaultErrorMapper
+	}
+	return func(err error) Error {
+		for _, rule := range rules {
+			if errors.Is(err, rule.Target) {
+				return rule.Error
+			}
+		}
+		return fallback(err)
+	}
+}
+
+// New builds an Envelope from a Result. If the Result is an Error, the error is
+// mapped using mapper, or DefaultErrorMapper if mapper is nil.
+func New[T any](res result.Result[T], mapper ErrorMapper) Envelope[T] {
+	val, err := res.Get()
+	if err != nil {
+		if mapper == nil {
+			mapper = DefaultErrorMapper
+		}
+		return Envelope[T]{Error: option.Some(mapper(err))}
+	}
+	return Envelope[T]{Data: option.SomeNillable(val)}
+}
+
+// Write writes the Envelope built from the Result as JSON. Successful responses
+// are written with the provided status, failed responses with the Status of the
+// mapped Error, or 500 if it isn't set.
+func Write[T any](w http.ResponseWriter, status int, res result.Result[T], mapper ErrorMapper) error {
+	env := New(res, mapper)
+	if e, ok := env.Error.Get(); ok {
+		status = e.Status
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+	}
+	body, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, err = w.Write(body)
+	return err
+}
@@ -0,0 +1,112 @@
+package respond
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+var errNotFound = errors.New("not found")
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func TestNew(t *testing.T) {
+	mapper := Rules(nil, Rule{
+		Target: errNotFound,
+		Error:  Error{Status: http.StatusNotFound, Code: "not_found", Message: "user not found"},
+	})
+
+	tests := []struct {
+		name     string
+		res      result.Result[user]
+		mapper   ErrorMapper
+		expected string
+	}{
+		{
+			name:     "Ok",
+			res:      result.Ok(user{Name: "Billy"}),
+			mapper:   mapper,
+			expected: `{"data":{"name":"Billy"}}`,
+		},
+		{
+			name:     "Mapped Error",
+			res:      result.Error[user](fmt.Errorf("lookup: %w", errNotFound)),
+			mapper:   mapper,
+			expected: `{"error":{"code":"not_found","message":"user not found"}}`,
+		},
+		{
+			name:     "Unmapped Error",
+			res:      result.Error[user](errors.New("db exploded")),
+			mapper:   mapper,
+			expected: `{"error":{"code":"internal","message":"internal server error"}}`,
+		},
+		{
+			name:     "Nil Mapper",
+			res:      result.Error[user](errNotFound),
+			expected: `{"error":{"code":"internal","message":"internal server error"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(New(test.res, test.mapper))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(data))
+		})
+	}
+}
+
+func TestNew_NilValue(t *testing.T) {
+	env := New(result.Ok[any](nil), nil)
+	assert.False(t, env.Data.IsSome())
+
+	data, err := json.Marshal(env)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"data":null}`, string(data))
+}
+
+func TestEnvelope_ExplicitEncoding(t *testing.T) {
+	defer option.SetEncoding(option.EncodeNull)
+	option.SetEncoding(option.EncodeExplicit)
+
+	data, err := json.Marshal(New(result.Ok(42), nil))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"data":42}`, string(data))
+
+	data, err = json.Marshal(New(result.Error[int](errNotFound), nil))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"error":{"code":"internal","message":"internal server error"}}`, string(data))
+}
+
+func TestWrite(t *testing.T) {
+	mapper := Rules(nil, Rule{
+		Target: errNotFound,
+		Error:  Error{Status: http.StatusNotFound, Code: "not_found", Message: "user not found"},
+	})
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, Write(rec, http.StatusCreated, result.Ok(user{Name: "Billy"}), mapper))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"data":{"name":"Billy"}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	assert.NoError(t, Write(rec, http.StatusOK, result.Error[user](errNotFound), mapper))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	custom := func(err error) Error { return Error{Code: "oops", Message: err.Error()} }
+	assert.NoError(t, Write(rec, http.StatusOK, result.Error[user](errors.New("boom")), custom))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{"error":{"code":"oops","message":"boom"}}`, rec.Body.String())
+}
@@ -0,0 +1,41 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPanic is wrapped by the error of Results produced from a recovered panic.
+var ErrPanic = errors.New("recovered from panic")
+
+// Capture invokes fn and converts its return values into a Result. If fn panics
+// the panic is recovered and an Error Result wrapping ErrPanic is returned. If
+// the panic value was an error it is also wrapped and can be matched using
+// errors.Is and errors.As.
+func Capture[T any](fn func() (T, error)) (res Result[T]) {
+	defer Guard(&res)
+	return From(fn())
+}
+
+// Guard recovers from a panic in the enclosing function and stores an Error
+// Result wrapping ErrPanic in res. Guard must be deferred directly and is
+// intended for functions with a named Result return value.
+//
+//	func (s *Service) Load(id string) (res result.Result[User]) {
+//		defer result.Guard(&res)
+//		...
+//	}
+//
+// If the enclosing function doesn't panic, Guard has no effect.
+func Guard[T any](res *Result[T]) {
+	if r := recover(); r != nil {
+		*res = Error[T](panicError(r))
+	}
+}
+
+func panicError(r any) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("%w: %w", ErrPanic, err)
+	}
+	return fmt.Errorf("%w: %v", ErrPanic, r)
+}
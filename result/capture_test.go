@@ -0,0 +1,54 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapture(t *testing.T) {
+	testErr := errors.New("test error")
+
+	res := Capture(func() (string, error) {
+		return "Billy", nil
+	})
+	assert.Equal(t, Ok("Billy"), res)
+
+	res = Capture(func() (string, error) {
+		return "", testErr
+	})
+	assert.ErrorIs(t, res.err, testErr)
+
+	res = Capture(func() (string, error) {
+		panic("boom")
+	})
+	assert.ErrorIs(t, res.err, ErrPanic)
+	assert.Contains(t, res.err.Error(), "boom")
+
+	res = Capture(func() (string, error) {
+		panic(testErr)
+	})
+	assert.ErrorIs(t, res.err, ErrPanic)
+	assert.ErrorIs(t, res.err, testErr)
+}
+
+func guarded(shouldPanic bool) (res Result[int]) {
+	defer Guard(&res)
+	if shouldPanic {
+		var m map[string]int
+		m["boom"] = 1
+	}
+	return Ok(42)
+}
+
+func TestGuard(t *testing.T) {
+	assert.Equal(t, Ok(42), guarded(false))
+
+	var res Result[int]
+	assert.NotPanics(t, func() {
+		res = guarded(true)
+	})
+	assert.True(t, res.IsErr())
+	assert.ErrorIs(t, res.err, ErrPanic)
+}
@@ -0,0 +1,36 @@
+package result
+
+import (
+	"context"
+	"errors"
+)
+
+// Degraded is a value along with whether it was produced by the fallback rather
+// than the primary source, so callers can annotate responses, ie as stale data.
+type Degraded[T any] struct {
+	Value T
+	// Degraded is true when Value came from the fallback.
+	Degraded bool
+	// Cause is the error of the primary source when Degraded is true.
+	Cause error
+}
+
+// Degrade invokes primary and returns its value if it succeeds. If primary fails,
+// fallback is invoked and its value is returned marked as Degraded along with the
+// error of primary. If both fail, an Error Result joining both errors is
+// returned.
+func Degrade[T any](
+	ctx context.Context,
+	primary func(ctx context.Context) Result[T],
+	fallback func(ctx context.Context) Result[T],
+) Result[Degraded[T]] {
+	val, primaryErr := primary(ctx).Get()
+	if primaryErr == nil {
+		return Ok(Degraded[T]{Value: val})
+	}
+	val, fallbackErr := fallback(ctx).Get()
+	if fallbackErr != nil {
+		return Error[Degraded[T]](errors.Join(primaryErr, fallbackErr))
+	}
+	return Ok(Degraded[T]{Value: val, Degraded: true, Cause: primaryErr})
+}
@@ -0,0 +1,29 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDegrade(t *testing.T) {
+	primaryErr := errors.New("primary unavailable")
+	fallbackErr := errors.New("cache miss")
+	failing := func(err error) func(ctx context.Context) Result[string] {
+		return func(ctx context.Context) Result[string] {
+			return Error[string](err)
+		}
+	}
+
+	res := Degrade(context.Background(), value("fresh"), value("stale"))
+	assert.Equal(t, Ok(Degraded[string]{Value: "fresh"}), res)
+
+	res = Degrade(context.Background(), failing(primaryErr), value("stale"))
+	assert.Equal(t, Ok(Degraded[string]{Value: "stale", Degraded: true, Cause: primaryErr}), res)
+
+	res = Degrade(context.Background(), failing(primaryErr), failing(fallbackErr))
+	assert.ErrorIs(t, res.err, primaryErr)
+	assert.ErrorIs(t, res.err, fallbackErr)
+}
@@ -0,0 +1,155 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+)
+
+var jsonNull = []byte("null")
+
+// ErrNullResult is the error contained in a Result decoded from JSON null when
+// using EncodeValue.
+var ErrNullResult = errors.New("result was encoded as null")
+
+// Encoding determines how a Result is represented in JSON.
+type Encoding int32
+
+const (
+	// EncodeExplicit encodes a Result as an object containing either the value or
+	// the error message, ie {"ok":true,"value":"Billy"} or {"ok":false,"error":"not found"}.
+	// This is the default Encoding.
+	EncodeExplicit Encoding = iota
+	// EncodeValue encodes an Ok Result as the value itself and an Error Result as
+	// null. Decoding null produces an Error Result containing ErrNullResult. This
+	// Encoding is lossy as the error is not encoded.
+	EncodeValue
+)
+
+var currentEncoding atomic.Int32
+
+// SetEncoding sets the process-wide Encoding used when marshalling and
+// unmarshalling Result to and from JSON.
+//
+// SetEncoding is intended to be called once during program initialization.
+// Individual fields can use Explicit or Nullable to opt out of the process-wide
+// Encoding.
+func SetEncoding(enc Encoding) {
+	currentEncoding.Store(int32(enc))
+}
+
+func jsonEncoding() Encoding {
+	return Encoding(currentEncoding.Load())
+}
+
+// Explicit is a Result that is always encoded using EncodeExplicit regardless of
+// the process-wide Encoding.
+type Explicit[T any] struct {
+	Result[T]
+}
+
+// MarshalJSON marshals the Explicit to JSON using EncodeExplicit.
+func (e Explicit[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e.Result, EncodeExplicit)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Explicit using EncodeExplicit.
+func (e *Explicit[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&e.Result, data, EncodeExplicit)
+}
+
+// Nullable is a Result that is always encoded using EncodeValue regardless of the
+// process-wide Encoding.
+type Nullable[T any] struct {
+	Result[T]
+}
+
+// MarshalJSON marshals the Nullable to JSON using EncodeValue.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(n.Result, EncodeValue)
+}
+
+// UnmarshalJSON unmarshalls JSON to the Nullable using EncodeValue.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(&n.Result, data, EncodeValue)
+}
+
+// MarshalJSON marshals the Result type to JSON representation.
+//
+// By default a Result is encoded as an object containing either the value or
+// the error message. The representation can be changed process-wide using
+// SetEncoding.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r, jsonEncoding())
+}
+
+// UnmarshalJSON unmarshalls JSON representation of Result to the Result type.
+//
+// Since only the error message is encoded, errors are decoded using errors.New
+// and won't match the original error with errors.Is.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(r, data, jsonEncoding())
+}
+
+type explicitJSON[T any] struct {
+	Ok    *bool   `json:"ok"`
+	Value *T      `json:"value,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+func marshalJSON[T any](r Result[T], enc Encoding) ([]byte, error) {
+	if enc == EncodeValue {
+		if r.err != nil {
+			return json.Marshal(nil)
+		}
+		return json.Marshal(r.val)
+	}
+
+	ok := r.err == nil
+	e := explicitJSON[T]{Ok: &ok}
+	if ok {
+		e.Value = &r.val
+	} else {
+		msg := r.err.Error()
+		e.Error = &msg
+	}
+	return json.Marshal(e)
+}
+
+func unmarshalJSON[T any](r *Result[T], data []byte, enc Encoding) error {
+	if enc == EncodeValue {
+		if len(data) <= 0 || bytes.Equal(data, jsonNull) {
+			*r = Error[T](ErrNullResult)
+			return nil
+		}
+		var v T
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		*r = Ok(v)
+		return nil
+	}
+
+	var e explicitJSON[T]
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	if e.Ok == nil {
+		return errors.New("explicitly encoded Result is missing the ok field")
+	}
+	if !*e.Ok {
+		msg := ""
+		if e.Error != nil {
+			msg = *e.Error
+		}
+		*r = Error[T](errors.New(msg))
+		return nil
+	}
+	var v T
+	if e.Value != nil {
+		v = *e.Value
+	}
+	*r = Ok(v)
+	return nil
+}
@@ -0,0 +1,73 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Ok("Billy"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true,"value":"Billy"}`, string(data))
+
+	data, err = json.Marshal(Error[string](errors.New("not found")))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ok":false,"error":"not found"}`, string(data))
+}
+
+func TestResult_UnmarshalJSON(t *testing.T) {
+	var res Result[string]
+	assert.NoError(t, json.Unmarshal([]byte(`{"ok":true,"value":"Billy"}`), &res))
+	assert.Equal(t, Ok("Billy"), res)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"ok":false,"error":"not found"}`), &res))
+	assert.True(t, res.IsErr())
+	assert.EqualError(t, res.err, "not found")
+
+	assert.Error(t, json.Unmarshal([]byte(`{"value":"Billy"}`), &res))
+}
+
+func TestSetEncoding(t *testing.T) {
+	defer SetEncoding(EncodeExplicit)
+	SetEncoding(EncodeValue)
+
+	data, err := json.Marshal(Ok(42))
+	assert.NoError(t, err)
+	assert.Equal(t, `42`, string(data))
+
+	data, err = json.Marshal(Error[int](errors.New("boom")))
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	var res Result[int]
+	assert.NoError(t, json.Unmarshal([]byte(`42`), &res))
+	assert.Equal(t, Ok(42), res)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &res))
+	assert.ErrorIs(t, res.err, ErrNullResult)
+}
+
+func TestExplicit(t *testing.T) {
+	defer SetEncoding(EncodeExplicit)
+	SetEncoding(EncodeValue)
+
+	data, err := json.Marshal(Explicit[int]{Ok(42)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true,"value":42}`, string(data))
+
+	var e Explicit[int]
+	assert.NoError(t, json.Unmarshal([]byte(`{"ok":true,"value":42}`), &e))
+	assert.Equal(t, 42, e.Unwrap())
+}
+
+func TestNullable(t *testing.T) {
+	data, err := json.Marshal(Nullable[int]{Ok(42)})
+	assert.NoError(t, err)
+	assert.Equal(t, `42`, string(data))
+
+	var n Nullable[int]
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &n))
+	assert.True(t, n.IsErr())
+}
@@ -0,0 +1,57 @@
+package result
+
+import (
+	"errors"
+)
+
+// Errors flattens the error of the Result into the individual failures it
+// aggregates. Errors joined with errors.Join, or any error implementing
+// Unwrap() []error such as validated.Errors, are split into their parts
+// recursively, while singly wrapped errors are kept intact so their context
+// isn't lost. If the Result is Ok, nil is returned.
+func Errors[T any](res Result[T]) []error {
+	if res.err == nil {
+		return nil
+	}
+	return flatten(res.err, nil)
+}
+
+func flatten(err error, out []error) []error {
+	if multi, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range multi.Unwrap() {
+			if e != nil {
+				out = flatten(e, out)
+			}
+		}
+		return out
+	}
+	return append(out, err)
+}
+
+// WalkErrors walks the tree of errors of the Result depth first, invoking fn with
+// every error in the tree including wrapping errors. If fn returns false the walk
+// stops. If the Result is Ok fn is never invoked.
+func WalkErrors[T any](res Result[T], fn func(err error) bool) {
+	if res.err != nil {
+		walk(res.err, fn)
+	}
+}
+
+func walk(err error, fn func(err error) bool) bool {
+	if !fn(err) {
+		return false
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, child := range e.Unwrap() {
+			if child != nil && !walk(child, fn) {
+				return false
+			}
+		}
+	default:
+		if child := errors.Unwrap(err); child != nil {
+			return walk(child, fn)
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := errors.New("c")
+	wrappedB := fmt.Errorf("loading b: %w", errB)
+
+	res := Error[int](errors.Join(errA, errors.Join(wrappedB, errC)))
+	assert.Equal(t, []error{errA, wrappedB, errC}, Errors(res))
+
+	assert.Equal(t, []error{errA}, Errors(Error[int](errA)))
+	assert.Nil(t, Errors(Ok(1)))
+}
+
+func TestWalkErrors(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	wrappedB := fmt.Errorf("loading b: %w", errB)
+	joined := errors.Join(errA, wrappedB)
+
+	var visited []error
+	WalkErrors(Error[int](joined), func(err error) bool {
+		visited = append(visited, err)
+		return true
+	})
+	assert.Equal(t, []error{joined, errA, wrappedB, errB}, visited)
+
+	visited = nil
+	WalkErrors(Error[int](joined), func(err error) bool {
+		visited = append(visited, err)
+		return err != errA
+	})
+	assert.Equal(t, []error{joined, errA}, visited)
+
+	WalkErrors(Ok(1), func(err error) bool {
+		t.Fatal("should not be called")
+		return true
+	})
+}
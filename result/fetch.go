@@ -0,0 +1,93 @@
+package result
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jkratz55/gonads"
+)
+
+// Fetch2 runs two independent operations concurrently and combines their values
+// into a Tuple. If any operation fails, the context passed to the others is
+// cancelled and an Error Result with the first error is returned once all the
+// operations have returned.
+func Fetch2[A, B any](
+	ctx context.Context,
+	fa func(ctx context.Context) Result[A],
+	fb func(ctx context.Context) Result[B],
+) Result[gonads.Tuple[A, B]] {
+	var out gonads.Tuple[A, B]
+	if err := fetchAll(ctx, into(fa, &out.First), into(fb, &out.Second)); err != nil {
+		return Error[gonads.Tuple[A, B]](err)
+	}
+	return Ok(out)
+}
+
+// Fetch3 is similar to Fetch2 but runs three operations.
+func Fetch3[A, B, C any](
+	ctx context.Context,
+	fa func(ctx context.Context) Result[A],
+	fb func(ctx context.Context) Result[B],
+	fc func(ctx context.Context) Result[C],
+) Result[gonads.Tuple3[A, B, C]] {
+	var out gonads.Tuple3[A, B, C]
+	if err := fetchAll(ctx, into(fa, &out.First), into(fb, &out.Second), into(fc, &out.Third)); err != nil {
+		return Error[gonads.Tuple3[A, B, C]](err)
+	}
+	return Ok(out)
+}
+
+// Fetch4 is similar to Fetch2 but runs four operations.
+func Fetch4[A, B, C, D any](
+	ctx context.Context,
+	fa func(ctx context.Context) Result[A],
+	fb func(ctx context.Context) Result[B],
+	fc func(ctx context.Context) Result[C],
+	fd func(ctx context.Context) Result[D],
+) Result[gonads.Tuple4[A, B, C, D]] {
+	var out gonads.Tuple4[A, B, C, D]
+	err := fetchAll(ctx, into(fa, &out.First), into(fb, &out.Second), into(fc, &out.Third), into(fd, &out.Fourth))
+	if err != nil {
+		return Error[gonads.Tuple4[A, B, C, D]](err)
+	}
+	return Ok(out)
+}
+
+// into adapts an operation to store its value in dst when it succeeds.
+func into[T any](fn func(ctx context.Context) Result[T], dst *T) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		val, err := fn(ctx).Get()
+		if err != nil {
+			return err
+		}
+		*dst = val
+		return nil
+	}
+}
+
+// fetchAll runs the operations concurrently, cancelling the rest on the first
+// failure, and returns the first error once all have returned.
+func fetchAll(ctx context.Context, fns ...func(ctx context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	for _, fn := range fns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(ctx); err != nil {
+				once.Do(func() {
+					first = err
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	return first
+}
@@ -0,0 +1,47 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+)
+
+func value[T any](val T) func(ctx context.Context) Result[T] {
+	return func(ctx context.Context) Result[T] {
+		return Ok(val)
+	}
+}
+
+func TestFetch2(t *testing.T) {
+	res := Fetch2(context.Background(), value(1), value("Billy"))
+	assert.Equal(t, Ok(gonads.Tuple[int, string]{First: 1, Second: "Billy"}), res)
+}
+
+func TestFetch3(t *testing.T) {
+	res := Fetch3(context.Background(), value(1), value("Billy"), value(true))
+	assert.Equal(t, Ok(gonads.Tuple3[int, string, bool]{First: 1, Second: "Billy", Third: true}), res)
+}
+
+func TestFetch4(t *testing.T) {
+	res := Fetch4(context.Background(), value(1), value("Billy"), value(true), value(2.5))
+	expected := gonads.Tuple4[int, string, bool, float64]{First: 1, Second: "Billy", Third: true, Fourth: 2.5}
+	assert.Equal(t, Ok(expected), res)
+}
+
+func TestFetch2_CancelOnFailure(t *testing.T) {
+	testErr := errors.New("boom")
+	failing := func(ctx context.Context) Result[int] {
+		return Error[int](testErr)
+	}
+	blocking := func(ctx context.Context) Result[string] {
+		<-ctx.Done()
+		return Error[string](ctx.Err())
+	}
+
+	res := Fetch2(context.Background(), failing, blocking)
+	assert.ErrorIs(t, res.err, testErr)
+}
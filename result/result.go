@@ -1,10 +1,17 @@
 package result
 
 import (
+	"errors"
+
 	"github.com/jkratz55/gonads"
 	"github.com/jkratz55/gonads/option"
 )
 
+// ErrUnspecified is the error of a failed Result created by OkOr, OkOrElse,
+// Validate, Ensure, or EnsureNot when the provided error is nil, so a failure is
+// never mistaken for success.
+var ErrUnspecified = errors.New("failed without an error")
+
 // Result is a type representing the result of an operation that can fail.
 //
 // A Result can be thought of in two states:
@@ -44,6 +51,69 @@ func Error[T any](err error) Result[T] {
 	}
 }
 
+// OkOr converts an Option into a Result. If the Option is Some, returns Ok with
+// the value, otherwise returns an Error with the provided error.
+//
+// The option package can't depend on result, so OkOr is a function rather than
+// a method on Option.
+//
+// If err is nil a None Option is converted to an Error wrapping ErrUnspecified.
+// The same applies to OkOrElse when the Supplier returns nil.
+func OkOr[T any](opt option.Option[T], err error) Result[T] {
+	if val, ok := opt.Get(); ok {
+		return Ok(val)
+	}
+	return failure[T](err)
+}
+
+// OkOrElse converts an Option into a Result. If the Option is Some, returns Ok
+// with the value, otherwise invokes the Supplier and returns an Error with the
+// error it supplies. OkOrElse is the lazy variant of OkOr and is preferred when
+// building the error is expensive.
+func OkOrElse[T any](opt option.Option[T], fn gonads.Supplier[error]) Result[T] {
+	if val, ok := opt.Get(); ok {
+		return Ok(val)
+	}
+	return failure[T](fn())
+}
+
+// Validate checks an Option using the predicate, returning Ok with the value if
+// the Option is Some and the predicate returns true, otherwise an Error with the
+// provided error. Validate is shorthand for OkOr(opt.Filter(pred), err), so if
+// err is nil an Option that fails validation is converted to an Error wrapping
+// ErrUnspecified.
+func Validate[T any](opt option.Option[T], pred gonads.Predicate[T], err error) Result[T] {
+	return OkOr(opt.Filter(pred), err)
+}
+
+// Ensure returns Ok with the value if the predicate returns true for val,
+// otherwise returns an Error with the provided error, or ErrUnspecified if err is
+// nil.
+func Ensure[T any](val T, pred gonads.Predicate[T], err error) Result[T] {
+	if !pred(val) {
+		return failure[T](err)
+	}
+	return Ok(val)
+}
+
+// EnsureNot returns Ok with the value if the predicate returns false for val,
+// otherwise returns an Error with the provided error, or ErrUnspecified if err is
+// nil.
+func EnsureNot[T any](val T, pred gonads.Predicate[T], err error) Result[T] {
+	if pred(val) {
+		return failure[T](err)
+	}
+	return Ok(val)
+}
+
+// failure returns an Error with err, substituting ErrUnspecified for a nil err.
+func failure[T any](err error) Result[T] {
+	if err == nil {
+		err = ErrUnspecified
+	}
+	return Error[T](err)
+}
+
 // IsOk returns a boolean indicating if the result is success or not
 func (r Result[T]) IsOk() bool {
 	return r.err == nil
@@ -132,6 +202,18 @@ func (r Result[T]) Expect(msg string) T {
 	return r.val
 }
 
+// MapErr transforms the error of the Result using fn, leaving an Ok Result
+// untouched. MapErr is useful for wrapping or translating errors in a chain.
+//
+// As with From, a nil error means success, so if fn returns nil the failure is
+// turned into an Ok Result containing the zero value of T.
+func (r Result[T]) MapErr(fn func(err error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Error[T](fn(r.err))
+}
+
 // Map maps a Result[T] -> Result[R] using the provided mapper function. If the Result
 // contained an error, an Error is returned with the error value untouched.
 func Map[T, R any](res Result[T], fn func(T) R) Result[R] {
@@ -140,3 +222,9 @@ func Map[T, R any](res Result[T], fn func(T) R) Result[R] {
 	}
 	return Ok(fn(res.val))
 }
+
+// MapErr transforms the error of the Result using fn, leaving an Ok Result
+// untouched. It is the function form of Result.MapErr.
+func MapErr[T any](res Result[T], fn func(err error) error) Result[T] {
+	return res.MapErr(fn)
+}
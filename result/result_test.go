@@ -2,6 +2,7 @@ package result
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +60,65 @@ func TestError(t *testing.T) {
 	assert.Equal(t, "", res.val)
 }
 
+func TestOkOr(t *testing.T) {
+	testErr := errors.New("missing value")
+
+	res := OkOr(option.Some(42), testErr)
+	assert.Equal(t, Ok(42), res)
+
+	res = OkOr(option.None[int](), testErr)
+	assert.ErrorIs(t, res.err, testErr)
+
+	// A nil error doesn't turn the missing value into success.
+	assert.ErrorIs(t, OkOr(option.None[int](), nil).err, ErrUnspecified)
+	assert.ErrorIs(t, OkOrElse(option.None[int](), func() error { return nil }).err, ErrUnspecified)
+}
+
+func TestOkOrElse(t *testing.T) {
+	testErr := errors.New("missing value")
+	called := false
+	supplier := func() error {
+		called = true
+		return testErr
+	}
+
+	res := OkOrElse(option.Some(42), supplier)
+	assert.Equal(t, Ok(42), res)
+	assert.False(t, called)
+
+	res = OkOrElse(option.None[int](), supplier)
+	assert.ErrorIs(t, res.err, testErr)
+	assert.True(t, called)
+}
+
+func TestValidate(t *testing.T) {
+	errInvalidAge := errors.New("invalid age")
+	adult := func(age int) bool { return age >= 18 }
+
+	assert.Equal(t, Ok(21), Validate(option.Some(21), adult, errInvalidAge))
+	assert.ErrorIs(t, Validate(option.Some(12), adult, errInvalidAge).err, errInvalidAge)
+	assert.ErrorIs(t, Validate(option.None[int](), adult, errInvalidAge).err, errInvalidAge)
+	assert.ErrorIs(t, Validate(option.Some(12), adult, nil).err, ErrUnspecified)
+}
+
+func TestEnsure(t *testing.T) {
+	errNegative := errors.New("must not be negative")
+	positive := func(val int) bool { return val >= 0 }
+
+	assert.Equal(t, Ok(5), Ensure(5, positive, errNegative))
+	assert.ErrorIs(t, Ensure(-5, positive, errNegative).err, errNegative)
+	assert.ErrorIs(t, Ensure(-5, positive, nil).err, ErrUnspecified)
+}
+
+func TestEnsureNot(t *testing.T) {
+	errEmpty := errors.New("must not be empty")
+	empty := func(val string) bool { return val == "" }
+
+	assert.Equal(t, Ok("Billy"), EnsureNot("Billy", empty, errEmpty))
+	assert.ErrorIs(t, EnsureNot("", empty, errEmpty).err, errEmpty)
+	assert.ErrorIs(t, EnsureNot("", empty, nil).err, ErrUnspecified)
+}
+
 func TestResult_IsOk(t *testing.T) {
 	testErr := errors.New("test error")
 	tests := []struct {
@@ -323,6 +383,33 @@ func TestResult_Expect(t *testing.T) {
 	res.Expect("critical operation failed")
 }
 
+func TestResult_MapErr(t *testing.T) {
+	sentinel := errors.New("not found")
+	wrap := func(err error) error {
+		return fmt.Errorf("load user: %w", err)
+	}
+
+	res := Error[int](sentinel).MapErr(wrap)
+	assert.EqualError(t, res.err, "load user: not found")
+	assert.ErrorIs(t, res.err, sentinel)
+
+	called := false
+	res = Ok(1).MapErr(func(err error) error {
+		called = true
+		return err
+	})
+	assert.Equal(t, Ok(1), res)
+	assert.False(t, called)
+
+	assert.EqualError(t, MapErr(Error[int](sentinel), wrap).err, "load user: not found")
+	assert.Equal(t, Ok(1), MapErr(Ok(1), wrap))
+
+	// A nil error means success.
+	res = Error[int](sentinel).MapErr(func(err error) error { return nil })
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 0, res.Unwrap())
+}
+
 func TestMap(t *testing.T) {
 	ok := Ok(10)
 	res := Map(ok, func(val int) int {
@@ -0,0 +1,20 @@
+package result
+
+import (
+	"time"
+)
+
+// Timed is a Result along with how long the operation that produced it took,
+// allowing latency to travel with the outcome into logging and metrics hooks.
+type Timed[T any] struct {
+	Result   Result[T]
+	Duration time.Duration
+}
+
+// WithDuration returns a Timed carrying the Result and the provided duration.
+func (r Result[T]) WithDuration(d time.Duration) Timed[T] {
+	return Timed[T]{
+		Result:   r,
+		Duration: d,
+	}
+}
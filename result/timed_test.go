@@ -0,0 +1,20 @@
+package result
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult_WithDuration(t *testing.T) {
+	timed := Ok(42).WithDuration(time.Second)
+	assert.Equal(t, Ok(42), timed.Result)
+	assert.Equal(t, time.Second, timed.Duration)
+
+	testErr := errors.New("boom")
+	timed = Error[int](testErr).WithDuration(time.Millisecond)
+	assert.ErrorIs(t, timed.Result.err, testErr)
+	assert.Equal(t, time.Millisecond, timed.Duration)
+}
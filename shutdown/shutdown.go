@@ -0,0 +1,183 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+var (
+	// ErrShutdownFailed is wrapped by the error returned from Shutdown when one
+	// or more closers failed.
+	ErrShutdownFailed = errors.New("shutdown failed")
+	// ErrAlreadyShutdown is returned when Shutdown is called more than once.
+	ErrAlreadyShutdown = errors.New("shutdown already performed")
+)
+
+// Closer releases the resources of a component, ie closing a database pool or
+// draining an HTTP server. A Closer should return promptly once the context is
+// done.
+type Closer func(ctx context.Context) error
+
+// CloserReport is the outcome of running a single Closer.
+type CloserReport struct {
+	Name     string
+	Priority int
+	Duration time.Duration
+	Err      error
+}
+
+// Report is the outcome of a shutdown.
+type Report struct {
+	Closers  []CloserReport
+	Duration time.Duration
+}
+
+// Failed returns the reports of the closers that returned an error or timed out.
+func (r Report) Failed() []CloserReport {
+	failed := make([]CloserReport, 0)
+	for _, c := range r.Closers {
+		if c.Err != nil {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+type registration struct {
+	name     string
+	priority int
+	timeout  time.Duration
+	close    Closer
+}
+
+// Coordinator orchestrates the graceful shutdown of an application's
+// components. Closers are run in priority order, lower priority values first,
+// and closers sharing a priority are run concurrently. Each Closer is given its
+// own timeout so a single hung component can't consume the entire shutdown
+// budget.
+//
+// Coordinator is safe for concurrent use. The zero value isn't usable and a
+// Coordinator needs to be created with New.
+type Coordinator struct {
+	mu       sync.Mutex
+	closers  []registration
+	timeout  time.Duration
+	shutdown bool
+}
+
+// New creates a Coordinator where closers registered without an explicit timeout
+// are given the default timeout.
+func New(defaultTimeout time.Duration) *Coordinator {
+	return &Coordinator{timeout: defaultTimeout}
+}
+
+// Register adds a Closer that will be run with the default timeout.
+func (c *Coordinator) Register(name string, priority int, closer Closer) {
+	c.RegisterWithTimeout(name, priority, c.timeout, closer)
+}
+
+// RegisterWithTimeout adds a Closer that will be run with the given timeout. A
+// timeout that isn't positive means the Closer is only bound by the context
+// passed to Shutdown.
+func (c *Coordinator) RegisterWithTimeout(name string, priority int, timeout time.Duration, closer Closer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closers = append(c.closers, registration{
+		name:     name,
+		priority: priority,
+		timeout:  timeout,
+		close:    closer,
+	})
+}
+
+// Shutdown runs every registered Closer and returns a Report of the outcome.
+//
+// If any Closer fails or exceeds its timeout, the returned Result contains an
+// error wrapping ErrShutdownFailed and every failure. The Report is available in
+// both cases through Result.Get. Every Closer is run even if an earlier one
+// fails. Shutdown can only be performed once, subsequent calls return
+// ErrAlreadyShutdown.
+func (c *Coordinator) Shutdown(ctx context.Context) result.Result[Report] {
+	c.mu.Lock()
+	if c.shutdown {
+		c.mu.Unlock()
+		return result.Error[Report](ErrAlreadyShutdown)
+	}
+	c.shutdown = true
+	closers := make([]registration, len(c.closers))
+	copy(closers, c.closers)
+	c.mu.Unlock()
+
+	sort.SliceStable(closers, func(i, j int) bool {
+		return closers[i].priority < closers[j].priority
+	})
+
+	start := time.Now()
+	report := Report{Closers: make([]CloserReport, len(closers))}
+	for i := 0; i < len(closers); {
+		j := i
+		for j < len(closers) && closers[j].priority == closers[i].priority {
+			j++
+		}
+		var wg sync.WaitGroup
+		for k := i; k < j; k++ {
+			wg.Add(1)
+			go func(k int) {
+				defer wg.Done()
+				report.Closers[k] = run(ctx, closers[k])
+			}(k)
+		}
+		wg.Wait()
+		i = j
+	}
+	report.Duration = time.Since(start)
+
+	errs := make([]error, 0)
+	for _, cr := range report.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", cr.Name, cr.Err))
+	}
+	if len(errs) > 0 {
+		return result.From(report, fmt.Errorf("%w: %w", ErrShutdownFailed, errors.Join(errs...)))
+	}
+	return result.Ok(report)
+}
+
+func run(ctx context.Context, reg registration) CloserReport {
+	if reg.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, reg.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("closer panicked: %v", r)
+			}
+		}()
+		done <- reg.close(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		// The closer didn't respect the context, it is abandoned so the rest of
+		// the shutdown can proceed.
+		err = ctx.Err()
+	}
+	return CloserReport{
+		Name:     reg.name,
+		Priority: reg.priority,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+}
@@ -0,0 +1,77 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoordinator_Shutdown(t *testing.T) {
+	var mu sync.Mutex
+	order := make([]string, 0)
+	closer := func(name string) Closer {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	c := New(time.Second)
+	c.Register("database", 2, closer("database"))
+	c.Register("http", 1, closer("http"))
+	c.Register("cache", 2, closer("cache"))
+
+	res := c.Shutdown(context.Background())
+	assert.True(t, res.IsOk())
+	assert.Equal(t, "http", order[0])
+	assert.ElementsMatch(t, []string{"database", "cache"}, order[1:])
+
+	report := res.Unwrap()
+	assert.Len(t, report.Closers, 3)
+	assert.Equal(t, "http", report.Closers[0].Name)
+	assert.Empty(t, report.Failed())
+
+	_, err := c.Shutdown(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrAlreadyShutdown)
+}
+
+func TestCoordinator_Failures(t *testing.T) {
+	testErr := errors.New("flush failed")
+	ranAfter := false
+
+	c := New(50 * time.Millisecond)
+	c.Register("queue", 1, func(ctx context.Context) error {
+		return testErr
+	})
+	c.Register("hung", 1, func(ctx context.Context) error {
+		select {}
+	})
+	c.RegisterWithTimeout("slow", 1, 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	c.Register("panics", 1, func(ctx context.Context) error {
+		panic("boom")
+	})
+	c.Register("logger", 2, func(ctx context.Context) error {
+		ranAfter = true
+		return nil
+	})
+
+	report, err := c.Shutdown(context.Background()).Get()
+	assert.ErrorIs(t, err, ErrShutdownFailed)
+	assert.ErrorIs(t, err, testErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "boom")
+	assert.True(t, ranAfter)
+
+	failed := report.Failed()
+	assert.Len(t, failed, 4)
+	assert.Len(t, report.Closers, 5)
+}
@@ -0,0 +1,43 @@
+package slicefn
+
+import (
+	"sort"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+)
+
+// BinarySearch searches a slice sorted in ascending order (as defined by the
+// Comparator) for the target value. If the target is found Some(index) is returned,
+// otherwise None.
+//
+// Unlike sort.Search and slices.BinarySearch, BinarySearch doesn't return the
+// index where the target would be inserted when it isn't present, which is a
+// common source of bugs when the result isn't checked correctly. If the slice
+// contains duplicates of the target, the index of the first one is returned.
+func BinarySearch[T any](s []T, target T, cmp gonads.Comparator[T]) option.Option[int] {
+	i := sort.Search(len(s), func(i int) bool {
+		return cmp(s[i], target) >= 0
+	})
+	if i < len(s) && cmp(s[i], target) == 0 {
+		return option.Some(i)
+	}
+	return option.None[int]()
+}
+
+// SearchBy returns Some with the smallest index in the slice for which the
+// predicate returns true, or None if the predicate doesn't return true for any
+// element.
+//
+// SearchBy uses binary search and requires that the predicate is false for some
+// (possibly empty) prefix of the slice and true for the remainder, the same
+// requirement as sort.Search.
+func SearchBy[T any](s []T, pred gonads.Predicate[T]) option.Option[int] {
+	i := sort.Search(len(s), func(i int) bool {
+		return pred(s[i])
+	})
+	if i < len(s) {
+		return option.Some(i)
+	}
+	return option.None[int]()
+}
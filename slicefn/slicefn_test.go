@@ -0,0 +1,72 @@
+package slicefn
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+)
+
+func TestBinarySearch(t *testing.T) {
+	s := []int{1, 3, 3, 5, 7, 9}
+
+	tests := []struct {
+		name     string
+		target   int
+		expected option.Option[int]
+	}{
+		{
+			name:     "First Element",
+			target:   1,
+			expected: option.Some(0),
+		},
+		{
+			name:     "Last Element",
+			target:   9,
+			expected: option.Some(5),
+		},
+		{
+			name:     "Duplicate",
+			target:   3,
+			expected: option.Some(1),
+		},
+		{
+			name:     "Missing Between",
+			target:   4,
+			expected: option.None[int](),
+		},
+		{
+			name:     "Missing After",
+			target:   10,
+			expected: option.None[int](),
+		},
+		{
+			name:     "Missing Before",
+			target:   0,
+			expected: option.None[int](),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, BinarySearch(s, test.target, cmp.Compare[int]))
+		})
+	}
+
+	assert.True(t, BinarySearch(nil, 1, cmp.Compare[int]).IsNone())
+}
+
+func TestSearchBy(t *testing.T) {
+	s := []int{1, 3, 5, 7, 9}
+	assert.Equal(t, option.Some(2), SearchBy(s, func(v int) bool {
+		return v >= 4
+	}))
+	assert.Equal(t, option.Some(0), SearchBy(s, func(v int) bool {
+		return v >= 0
+	}))
+	assert.True(t, SearchBy(s, func(v int) bool {
+		return v > 9
+	}).IsNone())
+}
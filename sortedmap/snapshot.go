@@ -0,0 +1,65 @@
+package sortedmap
+
+import (
+	"cmp"
+	"errors"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrInvalidSnapshot is returned when restoring a Snapshot that wasn't created by
+// SortedMap.Snapshot.
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+// Snapshot is a point in time copy of the entries of a SortedMap.
+type Snapshot[K cmp.Ordered, V any] struct {
+	entries []gonads.Pair[K, V]
+	valid   bool
+}
+
+// Len returns the number of entries in the Snapshot.
+func (s Snapshot[K, V]) Len() int {
+	return len(s.entries)
+}
+
+// Snapshot returns a copy of the entries of the SortedMap that can later be
+// passed to Restore. Values implementing gonads.Cloner are deep copied, other
+// values are copied by assignment.
+func (m *SortedMap[K, V]) Snapshot() Snapshot[K, V] {
+	entries := make([]gonads.Pair[K, V], 0, m.size)
+	walk(m.root, func(n *node[K, V]) bool {
+		entries = append(entries, gonads.Pair[K, V]{Key: n.key, Value: gonads.Clone(n.val)})
+		return true
+	})
+	return Snapshot[K, V]{entries: entries, valid: true}
+}
+
+// Restore replaces the entries of the SortedMap with the entries of the Snapshot.
+// The Snapshot is copied, so it can be restored multiple times. If the Snapshot
+// wasn't created by Snapshot an Error Result wrapping ErrInvalidSnapshot is
+// returned and the SortedMap is unchanged.
+func (m *SortedMap[K, V]) Restore(s Snapshot[K, V]) result.Result[gonads.Unit] {
+	if !s.valid {
+		return result.Error[gonads.Unit](ErrInvalidSnapshot)
+	}
+	m.root = build(s.entries)
+	m.size = len(s.entries)
+	return result.Ok(gonads.Unit{})
+}
+
+// build builds a balanced tree from entries sorted by key.
+func build[K cmp.Ordered, V any](entries []gonads.Pair[K, V]) *node[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	mid := len(entries) / 2
+	n := &node[K, V]{
+		key:   entries[mid].Key,
+		val:   gonads.Clone(entries[mid].Value),
+		left:  build(entries[:mid]),
+		right: build(entries[mid+1:]),
+	}
+	fix(n)
+	return n
+}
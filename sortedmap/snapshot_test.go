@@ -0,0 +1,50 @@
+package sortedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type list []int
+
+func (l list) Clone() list {
+	return append(list{}, l...)
+}
+
+func TestSortedMap_SnapshotRestore(t *testing.T) {
+	m := newTestMap()
+	snap := m.Snapshot()
+	assert.Equal(t, 4, snap.Len())
+
+	m.Put(50, "fifty")
+	m.Delete(10)
+	assert.True(t, m.Restore(snap).IsOk())
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, "ten", m.Get(10).Unwrap())
+	assert.True(t, m.Get(50).IsNone())
+	assert.Equal(t, 10, m.First().Unwrap().Key)
+	assert.Equal(t, 40, m.Last().Unwrap().Key)
+
+	// The Snapshot can be restored again after further modifications
+	m.Put(5, "five")
+	assert.True(t, m.Restore(snap).IsOk())
+	assert.True(t, m.Get(5).IsNone())
+}
+
+func TestSortedMap_SnapshotClones(t *testing.T) {
+	m := New[string, list]()
+	m.Put("a", list{1, 2})
+	snap := m.Snapshot()
+
+	m.Get("a").Unwrap()[0] = 100
+	assert.True(t, m.Restore(snap).IsOk())
+	assert.Equal(t, list{1, 2}, m.Get("a").Unwrap())
+}
+
+func TestSortedMap_RestoreInvalid(t *testing.T) {
+	m := newTestMap()
+	_, err := m.Restore(Snapshot[int, string]{}).Get()
+	assert.ErrorIs(t, err, ErrInvalidSnapshot)
+	assert.Equal(t, 4, m.Len())
+}
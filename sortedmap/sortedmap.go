@@ -0,0 +1,289 @@
+package sortedmap
+
+import (
+	"cmp"
+	"iter"
+	"reflect"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+)
+
+// SortedMap is a map that keeps its entries ordered by key. In addition to the
+// typical map operations it supports navigation queries such as finding the
+// nearest key less than or greater than a given key, which hash based maps can't
+// do efficiently.
+//
+// SortedMap is backed by a self-balancing (AVL) binary search tree so lookups,
+// insertions, and deletions are O(log n).
+//
+// SortedMap is not safe for concurrent use. The zero value is an empty SortedMap
+// ready to use.
+type SortedMap[K cmp.Ordered, V any] struct {
+	root *node[K, V]
+	size int
+}
+
+type node[K cmp.Ordered, V any] struct {
+	key    K
+	val    V
+	height int
+	left   *node[K, V]
+	right  *node[K, V]
+}
+
+// New creates a new empty SortedMap.
+func New[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// Len returns the number of entries in the SortedMap.
+func (m *SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Put associates the value with the key, replacing any existing value. Like
+// option.Some, Put panics if val is a nil interface value since Get couldn't
+// return it as Some.
+func (m *SortedMap[K, V]) Put(key K, val V) {
+	if reflect.TypeOf(val) == nil {
+		panic("cannot put a nil value in a SortedMap")
+	}
+	var added bool
+	m.root, added = insert(m.root, key, val)
+	if added {
+		m.size++
+	}
+}
+
+// Get returns Some(value) associated with the key, or None if the key doesn't
+// exist.
+func (m *SortedMap[K, V]) Get(key K) option.Option[V] {
+	n := m.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return option.Some(n.val)
+		}
+	}
+	return option.None[V]()
+}
+
+// Delete removes the key from the SortedMap, returning a boolean indicating if
+// the key existed.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	var removed bool
+	m.root, removed = remove(m.root, key)
+	if removed {
+		m.size--
+	}
+	return removed
+}
+
+// First returns the entry with the smallest key, or None if the SortedMap is
+// empty.
+func (m *SortedMap[K, V]) First() option.Option[gonads.Pair[K, V]] {
+	if m.root == nil {
+		return option.None[gonads.Pair[K, V]]()
+	}
+	return entry(minNode(m.root))
+}
+
+// Last returns the entry with the largest key, or None if the SortedMap is empty.
+func (m *SortedMap[K, V]) Last() option.Option[gonads.Pair[K, V]] {
+	if m.root == nil {
+		return option.None[gonads.Pair[K, V]]()
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return entry(n)
+}
+
+// Floor returns the entry with the greatest key less than or equal to the given
+// key, or None if there is no such key.
+func (m *SortedMap[K, V]) Floor(key K) option.Option[gonads.Pair[K, V]] {
+	var found *node[K, V]
+	n := m.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			found = n
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	if found == nil {
+		return option.None[gonads.Pair[K, V]]()
+	}
+	return entry(found)
+}
+
+// Ceiling returns the entry with the least key greater than or equal to the given
+// key, or None if there is no such key.
+func (m *SortedMap[K, V]) Ceiling(key K) option.Option[gonads.Pair[K, V]] {
+	var found *node[K, V]
+	n := m.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			found = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return entry(n)
+		}
+	}
+	if found == nil {
+		return option.None[gonads.Pair[K, V]]()
+	}
+	return entry(found)
+}
+
+// All returns an iterator over every entry in ascending key order.
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(m.root, func(n *node[K, V]) bool {
+			return yield(n.key, n.val)
+		})
+	}
+}
+
+// Range returns an iterator over the entries with a key in the half-open interval
+// [from, to) in ascending key order.
+func (m *SortedMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walkRange(m.root, from, to, yield)
+	}
+}
+
+func entry[K cmp.Ordered, V any](n *node[K, V]) option.Option[gonads.Pair[K, V]] {
+	return option.Some(gonads.Pair[K, V]{Key: n.key, Value: n.val})
+}
+
+func walk[K cmp.Ordered, V any](n *node[K, V], fn func(n *node[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walk(n.left, fn) && fn(n) && walk(n.right, fn)
+}
+
+func walkRange[K cmp.Ordered, V any](n *node[K, V], from, to K, fn func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if cmp.Less(from, n.key) && !walkRange(n.left, from, to, fn) {
+		return false
+	}
+	if cmp.Compare(n.key, from) >= 0 && cmp.Less(n.key, to) && !fn(n.key, n.val) {
+		return false
+	}
+	if cmp.Less(n.key, to) {
+		return walkRange(n.right, from, to, fn)
+	}
+	return true
+}
+
+func height[K cmp.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func fix[K cmp.Ordered, V any](n *node[K, V]) {
+	n.height = max(height(n.left), height(n.right)) + 1
+}
+
+func rotateRight[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	fix(n)
+	fix(l)
+	return l
+}
+
+func rotateLeft[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	fix(n)
+	fix(r)
+	return r
+}
+
+func balance[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	fix(n)
+	switch bf := height(n.left) - height(n.right); {
+	case bf > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func insert[K cmp.Ordered, V any](n *node[K, V], key K, val V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, val: val, height: 1}, true
+	}
+	var added bool
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left, added = insert(n.left, key, val)
+	case c > 0:
+		n.right, added = insert(n.right, key, val)
+	default:
+		n.val = val
+		return n, false
+	}
+	return balance(n), added
+}
+
+func remove[K cmp.Ordered, V any](n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left, removed = remove(n.left, key)
+	case c > 0:
+		n.right, removed = remove(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := minNode(n.right)
+		n.key, n.val = successor.key, successor.val
+		n.right, _ = remove(n.right, successor.key)
+		removed = true
+	}
+	return balance(n), removed
+}
+
+func minNode[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
@@ -0,0 +1,153 @@
+package sortedmap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+)
+
+func newTestMap() *SortedMap[int, string] {
+	m := New[int, string]()
+	m.Put(20, "twenty")
+	m.Put(10, "ten")
+	m.Put(30, "thirty")
+	m.Put(40, "forty")
+	return m
+}
+
+func TestSortedMap_PutGet(t *testing.T) {
+	m := newTestMap()
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, "ten", m.Get(10).Unwrap())
+	assert.True(t, m.Get(15).IsNone())
+
+	m.Put(10, "TEN")
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, "TEN", m.Get(10).Unwrap())
+}
+
+func TestSortedMap_ZeroValue(t *testing.T) {
+	var m SortedMap[string, int]
+	assert.Equal(t, 0, m.Len())
+	assert.True(t, m.First().IsNone())
+	assert.False(t, m.Delete("a"))
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, "a", m.First().Unwrap().Key)
+}
+
+func TestSortedMap_Delete(t *testing.T) {
+	m := newTestMap()
+	assert.True(t, m.Delete(20))
+	assert.False(t, m.Delete(20))
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.Get(20).IsNone())
+	assert.Equal(t, "thirty", m.Get(30).Unwrap())
+}
+
+func TestSortedMap_FirstLast(t *testing.T) {
+	m := New[int, string]()
+	assert.True(t, m.First().IsNone())
+	assert.True(t, m.Last().IsNone())
+
+	m = newTestMap()
+	assert.Equal(t, gonads.Pair[int, string]{Key: 10, Value: "ten"}, m.First().Unwrap())
+	assert.Equal(t, gonads.Pair[int, string]{Key: 40, Value: "forty"}, m.Last().Unwrap())
+}
+
+func TestSortedMap_Floor(t *testing.T) {
+	m := newTestMap()
+	assert.True(t, m.Floor(5).IsNone())
+	assert.Equal(t, 10, m.Floor(10).Unwrap().Key)
+	assert.Equal(t, 20, m.Floor(25).Unwrap().Key)
+	assert.Equal(t, 40, m.Floor(100).Unwrap().Key)
+}
+
+func TestSortedMap_Ceiling(t *testing.T) {
+	m := newTestMap()
+	assert.Equal(t, 10, m.Ceiling(5).Unwrap().Key)
+	assert.Equal(t, 30, m.Ceiling(25).Unwrap().Key)
+	assert.Equal(t, 40, m.Ceiling(40).Unwrap().Key)
+	assert.True(t, m.Ceiling(41).IsNone())
+}
+
+func TestSortedMap_PutNil(t *testing.T) {
+	m := New[string, error]()
+	assert.Panics(t, func() {
+		m.Put("a", nil)
+	})
+	assert.Equal(t, 0, m.Len())
+	assert.True(t, m.Get("a").IsNone())
+}
+
+func TestSortedMap_All(t *testing.T) {
+	m := newTestMap()
+	keys := make([]int, 0)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{10, 20, 30, 40}, keys)
+
+	keys = keys[:0]
+	for k := range m.All() {
+		keys = append(keys, k)
+		if k >= 20 {
+			break
+		}
+	}
+	assert.Equal(t, []int{10, 20}, keys)
+}
+
+func TestSortedMap_Range(t *testing.T) {
+	m := newTestMap()
+	keys := make([]int, 0)
+	for k := range m.Range(15, 40) {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{20, 30}, keys)
+
+	keys = keys[:0]
+	for k := range m.Range(10, 41) {
+		keys = append(keys, k)
+		if k == 30 {
+			break
+		}
+	}
+	assert.Equal(t, []int{10, 20, 30}, keys)
+}
+
+func TestSortedMap_Balanced(t *testing.T) {
+	m := New[int, int]()
+	expected := make(map[int]struct{})
+	for i := 0; i < 1000; i++ {
+		k := rand.Intn(500)
+		m.Put(k, k)
+		expected[k] = struct{}{}
+	}
+	for i := 0; i < 250; i++ {
+		k := rand.Intn(500)
+		_, exists := expected[k]
+		assert.Equal(t, exists, m.Delete(k))
+		delete(expected, k)
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	actual := make([]int, 0, m.Len())
+	for k := range m.All() {
+		actual = append(actual, k)
+	}
+	assert.Equal(t, keys, actual)
+	assert.Equal(t, len(keys), m.Len())
+	assert.LessOrEqual(t, height(m.root), 15)
+}
@@ -0,0 +1,52 @@
+package gonads
+
+// Predicate and Comparator can be passed directly to functions in the slices
+// package such as slices.IndexFunc, slices.DeleteFunc and slices.SortFunc since
+// their underlying types match. The adapters below cover the remaining standard
+// library signatures.
+
+// ToLess converts a Comparator into a less function, as used by sort.Slice and
+// similar APIs.
+func ToLess[T any](cmp Comparator[T]) func(a, b T) bool {
+	return func(a, b T) bool {
+		return cmp(a, b) < 0
+	}
+}
+
+// FromLess converts a less function into a Comparator.
+func FromLess[T any](less func(a, b T) bool) Comparator[T] {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// ByKey adapts a Predicate on map keys to the func(K, V) bool signature used by
+// maps.DeleteFunc.
+func ByKey[K, V any](p Predicate[K]) func(K, V) bool {
+	return func(key K, _ V) bool {
+		return p(key)
+	}
+}
+
+// ByValue adapts a Predicate on map values to the func(K, V) bool signature used
+// by maps.DeleteFunc.
+func ByValue[K, V any](p Predicate[V]) func(K, V) bool {
+	return func(_ K, val V) bool {
+		return p(val)
+	}
+}
+
+// ByPair adapts a Predicate on a Pair to the func(K, V) bool signature used by
+// maps.DeleteFunc.
+func ByPair[K, V any](p Predicate[Pair[K, V]]) func(K, V) bool {
+	return func(key K, val V) bool {
+		return p(Pair[K, V]{Key: key, Value: val})
+	}
+}
@@ -0,0 +1,60 @@
+package gonads_test
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads"
+)
+
+func TestStdlibAssignable(t *testing.T) {
+	var isEmpty gonads.Predicate[string] = func(s string) bool { return s == "" }
+	var byLen gonads.Comparator[string] = func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+
+	names := []string{"Billy", "", "Bob"}
+	assert.Equal(t, 1, slices.IndexFunc(names, isEmpty))
+
+	names = slices.DeleteFunc(names, isEmpty)
+	slices.SortFunc(names, byLen)
+	assert.Equal(t, []string{"Bob", "Billy"}, names)
+}
+
+func TestToLess(t *testing.T) {
+	names := []string{"Billy", "Bob", "Al"}
+	less := gonads.ToLess[string](strings.Compare)
+	sort.Slice(names, func(i, j int) bool { return less(names[i], names[j]) })
+	assert.Equal(t, []string{"Al", "Billy", "Bob"}, names)
+}
+
+func TestFromLess(t *testing.T) {
+	cmp := gonads.FromLess(func(a, b int) bool { return a < b })
+	assert.Equal(t, -1, cmp(1, 2))
+	assert.Equal(t, 1, cmp(2, 1))
+	assert.Equal(t, 0, cmp(2, 2))
+}
+
+func TestByKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	maps.DeleteFunc(m, gonads.ByKey[string, int](func(key string) bool { return key == "b" }))
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, m)
+}
+
+func TestByValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	maps.DeleteFunc(m, gonads.ByValue[string](func(val int) bool { return val%2 == 1 }))
+	assert.Equal(t, map[string]int{"b": 2}, m)
+}
+
+func TestByPair(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	maps.DeleteFunc(m, gonads.ByPair(func(p gonads.Pair[string, int]) bool {
+		return p.Key == "a" || p.Value == 3
+	}))
+	assert.Equal(t, map[string]int{"b": 2}, m)
+}
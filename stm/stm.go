@@ -0,0 +1,71 @@
+package stm
+
+import (
+	"sync/atomic"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+// Var is a variable that can be safely shared between goroutines and updated
+// using optimistic concurrency. Rather than holding a lock while computing the
+// new state, Update computes the new state from a snapshot and only publishes
+// it if no other goroutine has updated the Var in the meantime, otherwise the
+// update is retried against the latest state.
+//
+// The zero value is a Var holding the zero value of T and is ready to use.
+type Var[T any] struct {
+	state atomic.Pointer[box[T]]
+}
+
+// box gives every published state a unique identity, so a compare-and-swap
+// detects conflicts even if an update produces an identical value.
+type box[T any] struct {
+	val T
+}
+
+// New creates a Var holding the initial value.
+func New[T any](initial T) *Var[T] {
+	v := &Var[T]{}
+	v.state.Store(&box[T]{val: initial})
+	return v
+}
+
+// Load returns the current value of the Var.
+func (v *Var[T]) Load() T {
+	if b := v.state.Load(); b != nil {
+		return b.val
+	}
+	var zero T
+	return zero
+}
+
+// Store unconditionally replaces the value of the Var.
+func (v *Var[T]) Store(val T) {
+	v.state.Store(&box[T]{val: val})
+}
+
+// Update transitions the Var to a new state computed by fn from the current
+// state. If fn returns an Error Result the Var is left unchanged and the Error is
+// returned. If another goroutine updated the Var while fn was running, fn is
+// invoked again with the latest state.
+//
+// Since fn may be invoked multiple times it must be free of side effects and
+// shouldn't mutate the state it is given, for example a slice or map held by
+// the Var should be copied rather than modified in place.
+func (v *Var[T]) Update(fn func(T) result.Result[T]) result.Result[T] {
+	for {
+		old := v.state.Load()
+		var cur T
+		if old != nil {
+			cur = old.val
+		}
+		res := fn(cur)
+		next, err := res.Get()
+		if err != nil {
+			return res
+		}
+		if v.state.CompareAndSwap(old, &box[T]{val: next}) {
+			return res
+		}
+	}
+}
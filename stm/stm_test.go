@@ -0,0 +1,67 @@
+package stm
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/result"
+)
+
+var errInsufficientFunds = errors.New("insufficient funds")
+
+func withdraw(amount int) func(int) result.Result[int] {
+	return func(balance int) result.Result[int] {
+		if balance < amount {
+			return result.Error[int](errInsufficientFunds)
+		}
+		return result.Ok(balance - amount)
+	}
+}
+
+func TestVar_ZeroValue(t *testing.T) {
+	var v Var[int]
+	assert.Equal(t, 0, v.Load())
+	res := v.Update(func(i int) result.Result[int] {
+		return result.Ok(i + 1)
+	})
+	assert.Equal(t, 1, res.Unwrap())
+	assert.Equal(t, 1, v.Load())
+}
+
+func TestVar_Update(t *testing.T) {
+	v := New(100)
+	assert.Equal(t, 70, v.Update(withdraw(30)).Unwrap())
+
+	_, err := v.Update(withdraw(100)).Get()
+	assert.ErrorIs(t, err, errInsufficientFunds)
+	assert.Equal(t, 70, v.Load())
+
+	v.Store(5)
+	assert.Equal(t, 5, v.Load())
+}
+
+func TestVar_UpdateConcurrent(t *testing.T) {
+	v := New(1000)
+	var wg sync.WaitGroup
+	failures := make(chan error, 200)
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := v.Update(withdraw(10)).Get(); err != nil {
+				failures <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(failures)
+
+	assert.Equal(t, 0, v.Load())
+	assert.Len(t, failures, 100)
+	for err := range failures {
+		assert.ErrorIs(t, err, errInsufficientFunds)
+	}
+}
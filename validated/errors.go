@@ -0,0 +1,70 @@
+package validated
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// FieldError is a validation error for a specific field. Field may be empty when
+// the error doesn't pertain to a specific field.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error returns the error message prefixed with the field name.
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Err.Error()
+	}
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying validation error.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON marshals the FieldError as an object containing the field and the
+// error message.
+func (e FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field   string `json:"field,omitempty"`
+		Message string `json:"message"`
+	}{
+		Field:   e.Field,
+		Message: e.Err.Error(),
+	})
+}
+
+// Errors is an accumulation of validation errors. Errors supports errors.Is and
+// errors.As against each of the contained errors, and marshals to JSON as an
+// array of field/message objects so it can be returned in an API response as is.
+type Errors []FieldError
+
+// Error returns all the error messages separated by a semicolon.
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns each of the contained errors.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Fields returns the error messages grouped by field name.
+func (e Errors) Fields() map[string][]string {
+	fields := make(map[string][]string)
+	for _, err := range e {
+		fields[err.Field] = append(fields[err.Field], err.Err.Error())
+	}
+	return fields
+}
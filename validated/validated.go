@@ -0,0 +1,132 @@
+package validated
+
+import (
+	"github.com/jkratz55/gonads/result"
+)
+
+// Validated is a type representing a value that has been validated. Unlike
+// Result, which short-circuits on the first error, Validated accumulates every
+// error encountered allowing all problems to be reported at once.
+//
+// A Validated can be thought of in two states:
+//
+//	Valid - The value passed validation
+//	Invalid - The value failed validation with one or more errors
+//
+// The zero value is Valid containing the zero value of T. A Validated is typically
+// created using one of the factory functions: Valid, Invalid, or Validate.
+type Validated[T any] struct {
+	val  T
+	errs []error
+}
+
+// Valid creates a Validated representing a value that passed validation.
+func Valid[T any](val T) Validated[T] {
+	return Validated[T]{val: val}
+}
+
+// Invalid creates a Validated representing a value that failed validation with
+// the provided errors. Any nil errors are discarded. If all the errors are nil
+// the Validated is Valid with the zero value of T.
+func Invalid[T any](errs ...error) Validated[T] {
+	return Validated[T]{errs: compact(errs)}
+}
+
+// Validate runs every check against the value, accumulating all the errors
+// returned. If no check returns an error the Validated is Valid.
+func Validate[T any](val T, checks ...func(T) error) Validated[T] {
+	errs := make([]error, 0)
+	for _, check := range checks {
+		if err := check(val); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return Validated[T]{errs: errs}
+	}
+	return Valid(val)
+}
+
+// IsValid returns a boolean indicating if the value passed validation.
+func (v Validated[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// IsInvalid returns a boolean indicating if the value failed validation.
+func (v Validated[T]) IsInvalid() bool {
+	return len(v.errs) > 0
+}
+
+// Errors returns the validation errors. If the Validated is Valid the returned
+// slice is empty.
+func (v Validated[T]) Errors() []error {
+	errs := make([]error, len(v.errs))
+	copy(errs, v.errs)
+	return errs
+}
+
+// Get returns the value along with the validation errors in a more idiomatic Go
+// way.
+func (v Validated[T]) Get() (T, []error) {
+	return v.val, v.Errors()
+}
+
+// Result converts the Validated into a Result. If the Validated is Invalid the
+// Result contains an Errors value holding all the validation errors.
+func (v Validated[T]) Result() result.Result[T] {
+	if len(v.errs) == 0 {
+		return result.Ok(v.val)
+	}
+	errs := make(Errors, 0, len(v.errs))
+	for _, err := range v.errs {
+		errs = append(errs, FieldError{Err: err})
+	}
+	return result.Error[T](errs)
+}
+
+// FieldBuilder sets a single field on a struct being constructed by Struct,
+// returning any validation errors for the field.
+type FieldBuilder[T any] func(dst *T) Errors
+
+// Field creates a FieldBuilder that assigns the value of the Validated to the
+// struct field using set when it is Valid. When it is Invalid, each error is
+// reported as a FieldError with the provided field name.
+func Field[T, F any](name string, v Validated[F], set func(dst *T, val F)) FieldBuilder[T] {
+	return func(dst *T) Errors {
+		if v.IsInvalid() {
+			errs := make(Errors, 0, len(v.errs))
+			for _, err := range v.errs {
+				errs = append(errs, FieldError{Field: name, Err: err})
+			}
+			return errs
+		}
+		set(dst, v.val)
+		return nil
+	}
+}
+
+// Struct constructs a value of T by running every FieldBuilder. Unlike chaining
+// Results, every field is validated and all errors across all fields are
+// accumulated into a single Errors value, making it suitable to return directly
+// to a client.
+func Struct[T any](builders ...FieldBuilder[T]) result.Result[T] {
+	var dst T
+	errs := make(Errors, 0)
+	for _, build := range builders {
+		errs = append(errs, build(&dst)...)
+	}
+	if len(errs) > 0 {
+		return result.Error[T](errs)
+	}
+	return result.Ok(dst)
+}
+
+func compact(errs []error) []error {
+	out := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			out = append(out, err)
+		}
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package validated
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errEmpty    = errors.New("must not be empty")
+	errTooLong  = errors.New("must be at most 5 characters")
+	errNegative = errors.New("must not be negative")
+)
+
+func notEmpty(s string) error {
+	if s == "" {
+		return errEmpty
+	}
+	return nil
+}
+
+func maxLen(s string) error {
+	if len(s) > 5 {
+		return errTooLong
+	}
+	return nil
+}
+
+func nonNegative(i int) error {
+	if i < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+func TestValid(t *testing.T) {
+	v := Valid("Billy")
+	assert.True(t, v.IsValid())
+	assert.False(t, v.IsInvalid())
+	val, errs := v.Get()
+	assert.Equal(t, "Billy", val)
+	assert.Empty(t, errs)
+}
+
+func TestInvalid(t *testing.T) {
+	v := Invalid[string](errEmpty, nil)
+	assert.True(t, v.IsInvalid())
+	assert.Equal(t, []error{errEmpty}, v.Errors())
+
+	v = Invalid[string](nil)
+	assert.True(t, v.IsValid())
+}
+
+func TestValidate(t *testing.T) {
+	v := Validate("Billy", notEmpty, maxLen)
+	assert.True(t, v.IsValid())
+
+	v = Validate("Billy Bob", notEmpty, maxLen)
+	assert.Equal(t, []error{errTooLong}, v.Errors())
+}
+
+func TestValidated_Result(t *testing.T) {
+	assert.Equal(t, "Billy", Valid("Billy").Result().Unwrap())
+
+	_, err := Invalid[string](errEmpty, errTooLong).Result().Get()
+	assert.ErrorIs(t, err, errEmpty)
+	assert.ErrorIs(t, err, errTooLong)
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func TestStruct(t *testing.T) {
+	res := Struct(
+		Field("name", Validate("Billy", notEmpty, maxLen), func(u *user, v string) { u.Name = v }),
+		Field("age", Validate(30, nonNegative), func(u *user, v int) { u.Age = v }),
+	)
+	assert.Equal(t, user{Name: "Billy", Age: 30}, res.Unwrap())
+
+	res = Struct(
+		Field("name", Validate("", notEmpty, maxLen), func(u *user, v string) { u.Name = v }),
+		Field("age", Validate(-1, nonNegative), func(u *user, v int) { u.Age = v }),
+	)
+	assert.True(t, res.IsErr())
+	_, err := res.Get()
+	assert.ErrorIs(t, err, errEmpty)
+	assert.ErrorIs(t, err, errNegative)
+	assert.Equal(t, "name: must not be empty; age: must not be negative", err.Error())
+
+	var errs Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, map[string][]string{
+		"name": {"must not be empty"},
+		"age":  {"must not be negative"},
+	}, errs.Fields())
+
+	data, jsonErr := json.Marshal(errs)
+	assert.NoError(t, jsonErr)
+	assert.JSONEq(t, `[{"field":"name","message":"must not be empty"},{"field":"age","message":"must not be negative"}]`, string(data))
+}
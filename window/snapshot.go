@@ -0,0 +1,73 @@
+package window
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/result"
+)
+
+var (
+	// ErrInvalidSnapshot is returned when restoring a Snapshot that wasn't created
+	// by Sliding.Snapshot.
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+	// ErrIncompatibleSnapshot is returned when restoring a Snapshot taken from a
+	// window with different bounds.
+	ErrIncompatibleSnapshot = errors.New("snapshot taken from a window with different bounds")
+)
+
+// Snapshot is a point in time copy of the values of a Sliding window.
+type Snapshot[T any] struct {
+	maxCount int
+	maxAge   time.Duration
+	entries  []entry[T]
+	valid    bool
+}
+
+// Len returns the number of values in the Snapshot.
+func (s Snapshot[T]) Len() int {
+	return len(s.entries)
+}
+
+// Snapshot returns a copy of the values in the window, including when they were
+// added, that can later be passed to Restore. Values implementing gonads.Cloner
+// are deep copied, other values are copied by assignment.
+func (w *Sliding[T]) Snapshot() Snapshot[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.expire()
+	return Snapshot[T]{
+		maxCount: w.maxCount,
+		maxAge:   w.maxAge,
+		entries:  cloneEntries(w.entries),
+		valid:    true,
+	}
+}
+
+// Restore replaces the values in the window with the values of the Snapshot.
+// Values in a time bounded window that expired since the Snapshot was taken are
+// dropped. If the Snapshot wasn't created by Snapshot, or was taken from a window
+// with different bounds, an Error Result is returned and the window is
+// unchanged.
+func (w *Sliding[T]) Restore(s Snapshot[T]) result.Result[gonads.Unit] {
+	if !s.valid {
+		return result.Error[gonads.Unit](ErrInvalidSnapshot)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if s.maxCount != w.maxCount || s.maxAge != w.maxAge {
+		return result.Error[gonads.Unit](ErrIncompatibleSnapshot)
+	}
+	w.entries = cloneEntries(s.entries)
+	w.expire()
+	return result.Ok(gonads.Unit{})
+}
+
+func cloneEntries[T any](entries []entry[T]) []entry[T] {
+	clone := make([]entry[T], len(entries))
+	for i, e := range entries {
+		clone[i] = entry[T]{val: gonads.Clone(e.val), at: e.at}
+	}
+	return clone
+}
@@ -0,0 +1,50 @@
+package window
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliding_SnapshotRestore(t *testing.T) {
+	w := NewCount[int](3)
+	w.Add(1)
+	w.Add(2)
+	snap := w.Snapshot()
+	assert.Equal(t, 2, snap.Len())
+
+	w.Add(3)
+	w.Add(4)
+	assert.True(t, w.Restore(snap).IsOk())
+	assert.Equal(t, []int{1, 2}, w.Values())
+
+	w.Add(3)
+	w.Add(4)
+	assert.Equal(t, []int{2, 3, 4}, w.Values())
+}
+
+func TestSliding_RestoreExpired(t *testing.T) {
+	clock := useFakeClock(t)
+	w := NewTime[int](time.Minute)
+	w.Add(1)
+	clock.t = clock.t.Add(30 * time.Second)
+	w.Add(2)
+	snap := w.Snapshot()
+
+	clock.t = clock.t.Add(45 * time.Second)
+	assert.True(t, w.Restore(snap).IsOk())
+	assert.Equal(t, []int{2}, w.Values())
+}
+
+func TestSliding_RestoreErrors(t *testing.T) {
+	w := NewCount[int](3)
+	_, err := w.Restore(Snapshot[int]{}).Get()
+	assert.ErrorIs(t, err, ErrInvalidSnapshot)
+
+	_, err = w.Restore(NewCount[int](5).Snapshot()).Get()
+	assert.ErrorIs(t, err, ErrIncompatibleSnapshot)
+
+	_, err = w.Restore(NewTime[int](time.Minute).Snapshot()).Get()
+	assert.ErrorIs(t, err, ErrIncompatibleSnapshot)
+}
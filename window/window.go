@@ -0,0 +1,144 @@
+package window
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jkratz55/gonads"
+	"github.com/jkratz55/gonads/option"
+)
+
+// now is the clock used for expiring values, it is replaced in tests.
+var now = time.Now
+
+type entry[T any] struct {
+	val T
+	at  time.Time
+}
+
+// Sliding is a sliding window of the most recently added values, bounded either
+// by the number of values or by how long ago they were added. Sliding is useful
+// for rolling statistics such as the maximum latency over the last minute.
+//
+// Aggregations return an Option since the window may be empty, for example when
+// all the values have expired.
+//
+// Sliding is safe for concurrent use. The zero value isn't usable and a Sliding
+// needs to be created with NewCount or NewTime.
+type Sliding[T any] struct {
+	mu       sync.Mutex
+	maxCount int
+	maxAge   time.Duration
+	entries  []entry[T]
+}
+
+// NewCount creates a Sliding window that holds at most the n most recently added
+// values. NewCount panics if n is less than 1.
+func NewCount[T any](n int) *Sliding[T] {
+	if n < 1 {
+		panic("window size must be at least 1")
+	}
+	return &Sliding[T]{maxCount: n, entries: make([]entry[T], 0, n)}
+}
+
+// NewTime creates a Sliding window that holds the values added within the last d.
+// NewTime panics if d is not positive.
+func NewTime[T any](d time.Duration) *Sliding[T] {
+	if d <= 0 {
+		panic("window duration must be positive")
+	}
+	return &Sliding[T]{maxAge: d}
+}
+
+// Add adds a value to the window, evicting the oldest values that no longer fit.
+func (w *Sliding[T]) Add(val T) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.entries = append(w.entries, entry[T]{val: val, at: now()})
+	if w.maxCount > 0 && len(w.entries) > w.maxCount {
+		w.drop(len(w.entries) - w.maxCount)
+	}
+	w.expire()
+}
+
+// Len returns the number of values currently in the window.
+func (w *Sliding[T]) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.expire()
+	return len(w.entries)
+}
+
+// Values returns the values currently in the window from oldest to newest.
+func (w *Sliding[T]) Values() []T {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.expire()
+	vals := make([]T, 0, len(w.entries))
+	for _, e := range w.entries {
+		vals = append(vals, e.val)
+	}
+	return vals
+}
+
+// Reduce combines the values in the window from oldest to newest using fn. If
+// the window is empty None is returned. Like option.FromMap, a nil interface
+// result is returned as None since Some can't contain nil.
+func (w *Sliding[T]) Reduce(fn func(acc, val T) T) option.Option[T] {
+	vals := w.Values()
+	if len(vals) == 0 {
+		return option.None[T]()
+	}
+	acc := vals[0]
+	for _, val := range vals[1:] {
+		acc = fn(acc, val)
+	}
+	return option.When(any(acc) != nil, acc)
+}
+
+// MaxBy returns the largest value in the window according to the Comparator, or
+// None if the window is empty. If multiple values are equally large the oldest
+// is returned.
+func (w *Sliding[T]) MaxBy(cmp gonads.Comparator[T]) option.Option[T] {
+	return w.Reduce(func(acc, val T) T {
+		if cmp(val, acc) > 0 {
+			return val
+		}
+		return acc
+	})
+}
+
+// MinBy returns the smallest value in the window according to the Comparator, or
+// None if the window is empty. If multiple values are equally small the oldest
+// is returned.
+func (w *Sliding[T]) MinBy(cmp gonads.Comparator[T]) option.Option[T] {
+	return w.Reduce(func(acc, val T) T {
+		if cmp(val, acc) < 0 {
+			return val
+		}
+		return acc
+	})
+}
+
+// expire removes values older than the max age. The lock must be held.
+func (w *Sliding[T]) expire() {
+	if w.maxAge <= 0 {
+		return
+	}
+	cutoff := now().Add(-w.maxAge)
+	n := 0
+	for n < len(w.entries) && !w.entries[n].at.After(cutoff) {
+		n++
+	}
+	w.drop(n)
+}
+
+// drop removes the n oldest values. The lock must be held.
+func (w *Sliding[T]) drop(n int) {
+	if n <= 0 {
+		return
+	}
+	remaining := copy(w.entries, w.entries[n:])
+	clear(w.entries[remaining:])
+	w.entries = w.entries[:remaining]
+}
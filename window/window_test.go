@@ -0,0 +1,97 @@
+package window
+
+import (
+	"cmp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func useFakeClock(t *testing.T) *fakeClock {
+	clock := &fakeClock{t: time.Now()}
+	now = clock.now
+	t.Cleanup(func() {
+		now = time.Now
+	})
+	return clock
+}
+
+func sum(acc, val int) int {
+	return acc + val
+}
+
+func TestNewCount(t *testing.T) {
+	assert.Panics(t, func() {
+		NewCount[int](0)
+	})
+
+	w := NewCount[int](3)
+	assert.True(t, w.Reduce(sum).IsNone())
+	assert.True(t, w.MaxBy(cmp.Compare[int]).IsNone())
+	assert.True(t, w.MinBy(cmp.Compare[int]).IsNone())
+
+	for i := 1; i <= 5; i++ {
+		w.Add(i)
+	}
+	assert.Equal(t, 3, w.Len())
+	assert.Equal(t, []int{3, 4, 5}, w.Values())
+	assert.Equal(t, 12, w.Reduce(sum).Unwrap())
+	assert.Equal(t, 5, w.MaxBy(cmp.Compare[int]).Unwrap())
+	assert.Equal(t, 3, w.MinBy(cmp.Compare[int]).Unwrap())
+}
+
+func TestNewTime(t *testing.T) {
+	assert.Panics(t, func() {
+		NewTime[int](0)
+	})
+
+	clock := useFakeClock(t)
+	w := NewTime[int](time.Minute)
+
+	w.Add(10)
+	clock.t = clock.t.Add(30 * time.Second)
+	w.Add(20)
+	assert.Equal(t, []int{10, 20}, w.Values())
+
+	clock.t = clock.t.Add(30 * time.Second)
+	assert.Equal(t, []int{20}, w.Values())
+	assert.Equal(t, 20, w.MaxBy(cmp.Compare[int]).Unwrap())
+
+	clock.t = clock.t.Add(time.Minute)
+	assert.Equal(t, 0, w.Len())
+	assert.True(t, w.Reduce(sum).IsNone())
+}
+
+func TestSliding_ReduceNil(t *testing.T) {
+	w := NewCount[error](3)
+	w.Add(nil)
+	w.Add(nil)
+	assert.True(t, w.Reduce(func(acc, val error) error { return acc }).IsNone())
+}
+
+func TestSliding_MaxByOldestWins(t *testing.T) {
+	type sample struct {
+		id    int
+		value int
+	}
+	w := NewCount[sample](5)
+	w.Add(sample{1, 5})
+	w.Add(sample{2, 5})
+	w.Add(sample{3, 1})
+	w.Add(sample{4, 1})
+
+	byValue := func(a, b sample) int {
+		return cmp.Compare(a.value, b.value)
+	}
+	assert.Equal(t, 1, w.MaxBy(byValue).Unwrap().id)
+	assert.Equal(t, 3, w.MinBy(byValue).Unwrap().id)
+}
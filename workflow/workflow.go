@@ -0,0 +1,147 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+// ErrUnknownCheckpoint is returned when a checkpoint refers to a step that isn't
+// part of the Workflow, ie because the Workflow changed since the checkpoint was
+// saved.
+var ErrUnknownCheckpoint = errors.New("checkpoint refers to an unknown step")
+
+// Step is a single named unit of work that transforms the state of a Workflow.
+type Step[S any] struct {
+	Name string
+	Run  func(ctx context.Context, state S) result.Result[S]
+}
+
+// Checkpoint records the state of a Workflow after its last successful step.
+type Checkpoint[S any] struct {
+	Step  string
+	State S
+}
+
+// Store persists the checkpoints of Workflow runs, identified by a run id.
+type Store[S any] interface {
+	// Load returns the last saved Checkpoint for the run, or None if the run has
+	// no checkpoint.
+	Load(ctx context.Context, id string) result.Result[option.Option[Checkpoint[S]]]
+	// Save persists the Checkpoint for the run, replacing any previous Checkpoint.
+	Save(ctx context.Context, id string, cp Checkpoint[S]) error
+}
+
+// Workflow runs a sequence of steps over a state value, saving a checkpoint after
+// every successful step. If a run fails, running it again with the same id
+// resumes after the last successful step rather than starting over, which makes
+// long multi-step jobs safe to retry.
+//
+// Steps should be idempotent since a step may be run again if the process stops
+// after the step completed but before its checkpoint was saved.
+type Workflow[S any] struct {
+	steps []Step[S]
+	store Store[S]
+}
+
+// New creates a Workflow that runs the steps in order and persists checkpoints to
+// the Store. New panics if two steps share a name, since checkpoints identify
+// steps by name.
+func New[S any](store Store[S], steps ...Step[S]) *Workflow[S] {
+	seen := make(map[string]struct{}, len(steps))
+	for _, step := range steps {
+		if _, ok := seen[step.Name]; ok {
+			panic(fmt.Sprintf("workflow: duplicate step name %q", step.Name))
+		}
+		seen[step.Name] = struct{}{}
+	}
+	return &Workflow[S]{steps: steps, store: store}
+}
+
+// Run runs the Workflow for the given run id. If the run has a checkpoint, the
+// steps are resumed after the step recorded in the checkpoint using its state,
+// otherwise every step is run starting with the initial state.
+//
+// If a step fails, or the context is done between steps, an Error Result naming
+// the step is returned and the checkpoint of the last successful step is kept.
+// Running a run that already completed returns its final state without running
+// any steps.
+func (w *Workflow[S]) Run(ctx context.Context, id string, initial S) result.Result[S] {
+	cp, err := w.store.Load(ctx, id).Get()
+	if err != nil {
+		return result.Error[S](fmt.Errorf("load checkpoint: %w", err))
+	}
+
+	state := initial
+	start := 0
+	if last, ok := cp.Get(); ok {
+		idx := w.indexOf(last.Step)
+		if idx < 0 {
+			return result.Error[S](fmt.Errorf("%w: %q", ErrUnknownCheckpoint, last.Step))
+		}
+		state = last.State
+		start = idx + 1
+	}
+
+	for _, step := range w.steps[start:] {
+		if err := ctx.Err(); err != nil {
+			return result.Error[S](fmt.Errorf("step %q: %w", step.Name, err))
+		}
+		next, err := step.Run(ctx, state).Get()
+		if err != nil {
+			return result.Error[S](fmt.Errorf("step %q: %w", step.Name, err))
+		}
+		state = next
+		if err := w.store.Save(ctx, id, Checkpoint[S]{Step: step.Name, State: state}); err != nil {
+			return result.Error[S](fmt.Errorf("save checkpoint for step %q: %w", step.Name, err))
+		}
+	}
+	return result.Ok(state)
+}
+
+func (w *Workflow[S]) indexOf(name string) int {
+	for i, step := range w.steps {
+		if step.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// MemoryStore is an in-memory Store, useful for tests and for workflows that only
+// need to resume within the same process.
+//
+// MemoryStore is safe for concurrent use. The zero value isn't usable and a
+// MemoryStore needs to be created with NewMemoryStore.
+type MemoryStore[S any] struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint[S]
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore[S any]() *MemoryStore[S] {
+	return &MemoryStore[S]{checkpoints: make(map[string]Checkpoint[S])}
+}
+
+// Load returns the last saved Checkpoint for the run, or None if there is none.
+func (m *MemoryStore[S]) Load(_ context.Context, id string) result.Result[option.Option[Checkpoint[S]]] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.checkpoints[id]
+	if !ok {
+		return result.Ok(option.None[Checkpoint[S]]())
+	}
+	return result.Ok(option.Some(cp))
+}
+
+// Save saves the Checkpoint for the run.
+func (m *MemoryStore[S]) Save(_ context.Context, id string, cp Checkpoint[S]) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[id] = cp
+	return nil
+}
@@ -0,0 +1,129 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jkratz55/gonads/option"
+	"github.com/jkratz55/gonads/result"
+)
+
+type job struct {
+	Log []string
+}
+
+func appendStep(name string, calls map[string]int, fail map[string]bool) Step[job] {
+	return Step[job]{
+		Name: name,
+		Run: func(ctx context.Context, state job) result.Result[job] {
+			calls[name]++
+			if fail[name] {
+				return result.Error[job](errors.New(name + " failed"))
+			}
+			log := append(append([]string{}, state.Log...), name)
+			return result.Ok(job{Log: log})
+		},
+	}
+}
+
+func TestWorkflow_Run(t *testing.T) {
+	calls := make(map[string]int)
+	store := NewMemoryStore[job]()
+	w := New[job](store,
+		appendStep("extract", calls, nil),
+		appendStep("transform", calls, nil),
+		appendStep("load", calls, nil),
+	)
+
+	res := w.Run(context.Background(), "run-1", job{})
+	assert.Equal(t, []string{"extract", "transform", "load"}, res.Unwrap().Log)
+
+	cp := store.Load(context.Background(), "run-1").Unwrap()
+	assert.Equal(t, "load", cp.Unwrap().Step)
+
+	// A completed run returns the final state without running any steps
+	res = w.Run(context.Background(), "run-1", job{})
+	assert.Equal(t, []string{"extract", "transform", "load"}, res.Unwrap().Log)
+	assert.Equal(t, 1, calls["extract"])
+}
+
+func TestWorkflow_Resume(t *testing.T) {
+	calls := make(map[string]int)
+	fail := map[string]bool{"transform": true}
+	store := NewMemoryStore[job]()
+	w := New[job](store,
+		appendStep("extract", calls, fail),
+		appendStep("transform", calls, fail),
+		appendStep("load", calls, fail),
+	)
+
+	_, err := w.Run(context.Background(), "run-1", job{}).Get()
+	assert.ErrorContains(t, err, `step "transform"`)
+	assert.Equal(t, "extract", store.Load(context.Background(), "run-1").Unwrap().Unwrap().Step)
+
+	fail["transform"] = false
+	res := w.Run(context.Background(), "run-1", job{})
+	assert.Equal(t, []string{"extract", "transform", "load"}, res.Unwrap().Log)
+	assert.Equal(t, 1, calls["extract"])
+	assert.Equal(t, 2, calls["transform"])
+	assert.Equal(t, 1, calls["load"])
+}
+
+func TestWorkflow_UnknownCheckpoint(t *testing.T) {
+	store := NewMemoryStore[job]()
+	assert.NoError(t, store.Save(context.Background(), "run-1", Checkpoint[job]{Step: "removed"}))
+	w := New[job](store, appendStep("extract", map[string]int{}, nil))
+
+	_, err := w.Run(context.Background(), "run-1", job{}).Get()
+	assert.ErrorIs(t, err, ErrUnknownCheckpoint)
+}
+
+func TestWorkflow_ContextDone(t *testing.T) {
+	calls := make(map[string]int)
+	w := New[job](NewMemoryStore[job](), appendStep("extract", calls, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := w.Run(ctx, "run-1", job{}).Get()
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, calls["extract"])
+}
+
+type failingStore struct {
+	loadErr error
+	saveErr error
+}
+
+func (s failingStore) Load(context.Context, string) result.Result[option.Option[Checkpoint[job]]] {
+	if s.loadErr != nil {
+		return result.Error[option.Option[Checkpoint[job]]](s.loadErr)
+	}
+	return result.Ok(option.None[Checkpoint[job]]())
+}
+
+func (s failingStore) Save(context.Context, string, Checkpoint[job]) error {
+	return s.saveErr
+}
+
+func TestWorkflow_StoreErrors(t *testing.T) {
+	testErr := errors.New("store unavailable")
+	step := appendStep("extract", map[string]int{}, nil)
+
+	_, err := New[job](failingStore{loadErr: testErr}, step).Run(context.Background(), "run-1", job{}).Get()
+	assert.ErrorIs(t, err, testErr)
+
+	_, err = New[job](failingStore{saveErr: testErr}, step).Run(context.Background(), "run-1", job{}).Get()
+	assert.ErrorIs(t, err, testErr)
+}
+
+func TestNew_DuplicateStep(t *testing.T) {
+	assert.Panics(t, func() {
+		New[job](NewMemoryStore[job](),
+			appendStep("extract", nil, nil),
+			appendStep("extract", nil, nil),
+		)
+	})
+}